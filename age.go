@@ -0,0 +1,392 @@
+package gdate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// AgeQualifier describes how an age relates to the true age of a person.
+type AgeQualifier int
+
+const (
+	AgeExact AgeQualifier = 0 // the age is stated exactly, as in "aged 45"
+	AgeAbout AgeQualifier = 1 // the age is approximate, as in "about 45"
+	AgeUnder AgeQualifier = 2 // the true age is less than the age, as in "under 1"
+	AgeOver  AgeQualifier = 3 // the true age is at least the age, as in "over 21"
+)
+
+// AgeUnit is the smallest unit in which an age is stated.
+type AgeUnit int
+
+const (
+	AgeYears  AgeUnit = 0
+	AgeMonths AgeUnit = 1
+	AgeWeeks  AgeUnit = 2
+	AgeDays   AgeUnit = 3
+)
+
+// AgeConvention describes how the recorder of an age arrived at it.
+type AgeConvention int
+
+const (
+	// AgeCompleted is an age in completed years, months, weeks or days, so a person aged 45 is at least 45
+	// and not yet 46.
+	AgeCompleted AgeConvention = 0
+
+	// AgeCensus1841 is an age recorded in the 1841 census of England, Wales and Scotland, in which the ages
+	// of those aged 15 and over were to be rounded down to a multiple of 5, so a person aged 45 could be any
+	// age from 45 to 49. Ages that are not a multiple of 5 are taken to be exact.
+	AgeCensus1841 AgeConvention = 1
+)
+
+// Age is the age of a person as stated in a record such as a census or burial register, for example
+// "45", "45 years 2 months", "3 weeks", "under 1", "infant" or "about 45".
+type Age struct {
+	Y          int // years
+	M          int // months
+	W          int // weeks
+	D          int // days
+	Unit       AgeUnit
+	Qualifier  AgeQualifier
+	Convention AgeConvention
+}
+
+func (a *Age) String() string {
+	var parts []string
+	for _, c := range []struct {
+		n    int
+		stem string
+		unit AgeUnit
+	}{
+		{a.Y, "year", AgeYears},
+		{a.M, "month", AgeMonths},
+		{a.W, "week", AgeWeeks},
+		{a.D, "day", AgeDays},
+	} {
+		if c.n != 0 || (len(parts) == 0 && c.unit == a.Unit) {
+			parts = append(parts, pluralise(c.n, c.stem))
+		}
+	}
+	s := strings.Join(parts, " ")
+	switch a.Qualifier {
+	case AgeAbout:
+		return "about " + s
+	case AgeUnder:
+		return "under " + s
+	case AgeOver:
+		return "over " + s
+	}
+	return s
+}
+
+// span returns the smallest amount by which the true age may exceed the stated age, in years, months
+// and days
+func (a *Age) span() (int, int, int) {
+	switch a.Unit {
+	case AgeMonths:
+		return 0, 1, 0
+	case AgeWeeks:
+		return 0, 0, 7
+	case AgeDays:
+		return 0, 0, 1
+	}
+	if a.Convention == AgeCensus1841 && a.Y >= 15 && a.Y%5 == 0 && a.M == 0 && a.W == 0 && a.D == 0 {
+		return 5, 0, 0
+	}
+	return 1, 0, 0
+}
+
+// BirthDate returns the range of days on which a person of the stated age on the date at could have been
+// born, such as 31 Mar 1805-30 Mar 1806 for a person aged 45 on 30 Mar 1851. An approximate age is
+// widened by the About window of DefaultBounds.
+func BirthDate(at Date, age *Age) Date {
+	return DefaultBounds.BirthDate(at, age)
+}
+
+// BirthDate returns the range of days on which a person of the stated age on the date at could have been
+// born, using the bounds given to at by the policy. Ages are taken to be in completed units unless the
+// convention of the age says otherwise, so a person aged 45 on 30 Mar 1851 was born after 30 Mar 1805 and
+// on or before 30 Mar 1806. A person under an age was born after the day they would have reached it, and
+// one over an age was born on or before the day they reached it, with no earliest day. An approximate age
+// is widened by the About window of the policy, giving an Unknown date if the window is Unbounded.
+func (p BoundsPolicy) BirthDate(at Date, age *Age) Date {
+	c := calendarOf(at, nil)
+	r1, r2 := p.Bounds(at)
+	if (r1 == math.MinInt && r2 == math.MaxInt) || age == nil {
+		return &Unknown{C: c}
+	}
+
+	// The youngest and oldest ages, in years, months and days, with the oldest excluded
+	y, m, d := age.Y, age.M, 7*age.W+age.D
+	sy, sm, sd := age.span()
+	miny, minm, mind := y, m, d
+	maxy, maxm, maxd := y+sy, m+sm, d+sd
+	open := false
+	switch age.Qualifier {
+	case AgeAbout:
+		if p.About == Unbounded {
+			return &Unknown{C: c}
+		}
+		miny, maxy = max(y-p.About, 0), maxy+p.About
+		if miny == 0 && y < p.About {
+			minm, mind = 0, 0
+		}
+	case AgeUnder:
+		miny, minm, mind = 0, 0, 0
+		maxy, maxm, maxd = y, m, d
+	case AgeOver:
+		open = true
+	}
+
+	lo, hi := math.MinInt, math.MaxInt
+	if r1 != math.MinInt && !open {
+		lo = shiftDay(c, r1, -maxy, -maxm, -maxd) + 1
+	}
+	if r2 != math.MaxInt {
+		hi = shiftDay(c, r2, -miny, -minm, -mind)
+	}
+	if lo > hi {
+		return &Unknown{C: c}
+	}
+	return fromOpenBounds(c, lo, hi)
+}
+
+// ParseAge parses an age as written in a record, such as "45", "aged 45", "45 years 2 months",
+// "45y 2m 3d", "3 weeks", "about 45", "under 1", "over 21" or "infant". A number without a unit is a
+// number of years. An infant is under one year old.
+func ParseAge(s string) (*Age, error) {
+	toks := tokenize(s)
+	i := 0
+	skip := func(words ...string) bool {
+		for _, w := range words {
+			if i < len(toks) && toks[i].is(w) {
+				i++
+				if i < len(toks) && toks[i].is(".") {
+					i++
+				}
+				return true
+			}
+		}
+		return false
+	}
+
+	skip("aged", "age")
+	age := &Age{}
+	switch {
+	case skip("about", "abt", "circa", "ca", "c", "approx", "approximately", "~"):
+		age.Qualifier = AgeAbout
+	case skip("under", "below", "<"):
+		age.Qualifier = AgeUnder
+	case skip("over", "above", ">"):
+		age.Qualifier = AgeOver
+	case skip("less"):
+		age.Qualifier = AgeUnder
+		if !skip("than") {
+			return nil, fmt.Errorf("cannot parse %q as an age", s)
+		}
+	case skip("more"):
+		age.Qualifier = AgeOver
+		if !skip("than") {
+			return nil, fmt.Errorf("cannot parse %q as an age", s)
+		}
+	}
+
+	if age.Qualifier == AgeExact && skip("infant", "inf") {
+		if i != len(toks) {
+			return nil, fmt.Errorf("cannot parse %q as an age: unexpected text after infant", s)
+		}
+		return &Age{Y: 1, Qualifier: AgeUnder}, nil
+	}
+
+	found := false
+	for i < len(toks) {
+		if skip("and", ",", "old") {
+			continue
+		}
+		t := toks[i]
+		if t.kind != tokNumber || t.num < 0 {
+			return nil, fmt.Errorf("cannot parse %q as an age: expected a number at offset %d", s, t.pos)
+		}
+		i++
+		var unit AgeUnit
+		switch {
+		case skip("years", "year", "yrs", "yr", "y"):
+			unit = AgeYears
+		case skip("months", "month", "mths", "mth", "mos", "mo", "m"):
+			unit = AgeMonths
+		case skip("weeks", "week", "wks", "wk", "w"):
+			unit = AgeWeeks
+		case skip("days", "day", "dys", "d"):
+			unit = AgeDays
+		case !found:
+			unit = AgeYears
+		default:
+			return nil, fmt.Errorf("cannot parse %q as an age: expected a unit at offset %d", s, t.end())
+		}
+		if found && unit <= age.Unit {
+			return nil, fmt.Errorf("cannot parse %q as an age: units out of order at offset %d", s, t.pos)
+		}
+		switch unit {
+		case AgeYears:
+			age.Y = t.num
+		case AgeMonths:
+			age.M = t.num
+		case AgeWeeks:
+			age.W = t.num
+		case AgeDays:
+			age.D = t.num
+		}
+		age.Unit = unit
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("cannot parse %q as an age", s)
+	}
+	return age, nil
+}
+
+// ParseInterval parses an age as an interval. It accepts GEDCOM AGE values such as "45y 3m 2d", "> 45y",
+// "< 1y", "CHILD", "INFANT" and "STILLBORN", and the ages accepted by ParseAge such as "aged 4 months" or
+// "about 45". An age in years is a YearsInterval, an approximate age in years is an AboutYearsInterval and
+// any other age is a PreciseInterval, with weeks counted as seven days. Ages under or over another age
+// are a LessThanInterval or GreaterThanInterval and ages named by a word are a KeywordInterval.
+func ParseInterval(s string) (Interval, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "child":
+		return &KeywordInterval{K: AgeChild}, nil
+	case "infant":
+		return &KeywordInterval{K: AgeInfant}, nil
+	case "stillborn":
+		return &KeywordInterval{K: AgeStillborn}, nil
+	}
+	a, err := ParseAge(s)
+	if err != nil {
+		return nil, err
+	}
+	var in Interval = &YearsInterval{Y: a.Y}
+	if a.Unit != AgeYears {
+		in = &PreciseInterval{Y: a.Y, M: a.M, D: 7*a.W + a.D}
+	}
+	switch a.Qualifier {
+	case AgeAbout:
+		if a.Unit != AgeYears {
+			return nil, fmt.Errorf("cannot parse %q as an interval: approximate ages must be in years", s)
+		}
+		return &AboutYearsInterval{Y: a.Y}, nil
+	case AgeUnder:
+		return &LessThanInterval{In: in}, nil
+	case AgeOver:
+		return &GreaterThanInterval{In: in}, nil
+	}
+	return in, nil
+}
+
+// AgeOf returns the age described by an interval, such as one returned by ParseInterval, and true if
+// possible, false if the interval is unknown. A child is under 8 years old and an infant under 1 year.
+func AgeOf(in Interval) (*Age, bool) {
+	switch ti := in.(type) {
+	case nil, *UnknownInterval:
+		return nil, false
+	case *KeywordInterval:
+		switch ti.K {
+		case AgeChild:
+			return &Age{Y: 8, Qualifier: AgeUnder}, true
+		case AgeInfant:
+			return &Age{Y: 1, Qualifier: AgeUnder}, true
+		case AgeStillborn:
+			return &Age{Unit: AgeDays}, true
+		}
+		return nil, false
+	case *LessThanInterval:
+		a, ok := AgeOf(ti.In)
+		if !ok || a.Qualifier != AgeExact {
+			return nil, false
+		}
+		a.Qualifier = AgeUnder
+		return a, true
+	case *GreaterThanInterval:
+		a, ok := AgeOf(ti.In)
+		if !ok || a.Qualifier != AgeExact {
+			return nil, false
+		}
+		a.Qualifier = AgeOver
+		return a, true
+	case *AboutYearsInterval:
+		return &Age{Y: ti.Y, Qualifier: AgeAbout}, true
+	}
+	if pi, ok := AsPreciseInterval(in); ok {
+		a := &Age{Y: pi.Y, M: pi.M, D: pi.D}
+		switch {
+		case pi.D != 0:
+			a.Unit = AgeDays
+		case pi.M != 0:
+			a.Unit = AgeMonths
+		}
+		return a, true
+	}
+	if yi, ok := AsYearsInterval(in); ok {
+		return &Age{Y: yi.Y}, true
+	}
+	return nil, false
+}
+
+// GedcomAge formats an interval as a GEDCOM AGE value, such as "45y 3m 2d", "< 1y" or "INFANT", and
+// returns true if possible, false if the interval cannot be written in GEDCOM, as for unknown, approximate
+// or negative intervals.
+func GedcomAge(in Interval) (string, bool) {
+	switch ti := in.(type) {
+	case *KeywordInterval:
+		switch ti.K {
+		case AgeChild:
+			return "CHILD", true
+		case AgeInfant:
+			return "INFANT", true
+		case AgeStillborn:
+			return "STILLBORN", true
+		}
+		return "", false
+	case *LessThanInterval:
+		s, ok := gedcomDuration(ti.In)
+		return "< " + s, ok
+	case *GreaterThanInterval:
+		s, ok := gedcomDuration(ti.In)
+		return "> " + s, ok
+	}
+	return gedcomDuration(in)
+}
+
+// gedcomDuration formats an interval measured in years, months and days as a GEDCOM AGE duration
+func gedcomDuration(in Interval) (string, bool) {
+	var y, m, d int
+	zero := "0d"
+	switch ti := in.(type) {
+	case nil, *UnknownInterval, *AboutYearsInterval, *LessThanInterval, *GreaterThanInterval, *KeywordInterval:
+		return "", false
+	case *YearsInterval:
+		y, zero = ti.Y, "0y"
+	default:
+		pi, ok := AsPreciseInterval(in)
+		if !ok {
+			return "", false
+		}
+		y, m, d = pi.Y, pi.M, pi.D
+	}
+	if y < 0 || m < 0 || d < 0 {
+		return "", false
+	}
+	var parts []string
+	if y != 0 {
+		parts = append(parts, fmt.Sprintf("%dy", y))
+	}
+	if m != 0 {
+		parts = append(parts, fmt.Sprintf("%dm", m))
+	}
+	if d != 0 {
+		parts = append(parts, fmt.Sprintf("%dd", d))
+	}
+	if len(parts) == 0 {
+		return zero, true
+	}
+	return strings.Join(parts, " "), true
+}
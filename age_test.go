@@ -0,0 +1,240 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAge(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want *Age
+		str  string
+	}{
+		{s: "45", want: &Age{Y: 45}, str: "45 years"},
+		{s: "aged 45", want: &Age{Y: 45}, str: "45 years"},
+		{s: "45 years old", want: &Age{Y: 45}, str: "45 years"},
+		{s: "45 yrs. 2 mths", want: &Age{Y: 45, M: 2, Unit: AgeMonths}, str: "45 years 2 months"},
+		{s: "45y 2m 3d", want: &Age{Y: 45, M: 2, D: 3, Unit: AgeDays}, str: "45 years 2 months 3 days"},
+		{s: "1 year and 6 months", want: &Age{Y: 1, M: 6, Unit: AgeMonths}, str: "1 year 6 months"},
+		{s: "3 weeks", want: &Age{W: 3, Unit: AgeWeeks}, str: "3 weeks"},
+		{s: "0 days", want: &Age{Unit: AgeDays}, str: "0 days"},
+		{s: "about 45", want: &Age{Y: 45, Qualifier: AgeAbout}, str: "about 45 years"},
+		{s: "aged abt. 45", want: &Age{Y: 45, Qualifier: AgeAbout}, str: "about 45 years"},
+		{s: "under 1", want: &Age{Y: 1, Qualifier: AgeUnder}, str: "under 1 year"},
+		{s: "less than 6 months", want: &Age{M: 6, Unit: AgeMonths, Qualifier: AgeUnder}, str: "under 6 months"},
+		{s: "over 21", want: &Age{Y: 21, Qualifier: AgeOver}, str: "over 21 years"},
+		{s: "infant", want: &Age{Y: 1, Qualifier: AgeUnder}, str: "under 1 year"},
+		{s: "Inf.", want: &Age{Y: 1, Qualifier: AgeUnder}, str: "under 1 year"},
+		{s: "adult"},
+		{s: "2 months 1 year"},
+		{s: "45 2"},
+		{s: "infant 2"},
+		{s: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := ParseAge(tc.s)
+			if tc.want == nil {
+				if err == nil {
+					t.Fatalf("got %v, wanted error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseAge(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+			if got := got.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+		})
+	}
+}
+
+func TestBirthDate(t *testing.T) {
+	census1851 := &Precise{Y: 1851, M: 3, D: 30}
+	census1841 := &Precise{Y: 1841, M: 6, D: 6}
+	testCases := []struct {
+		name string
+		at   Date
+		age  *Age
+		want Date
+	}{
+		{
+			name: "years",
+			at:   census1851,
+			age:  &Age{Y: 45},
+			want: &BetweenPrecise{StartYear: 1805, StartMonth: 3, StartDay: 31, EndYear: 1806, EndMonth: 3, EndDay: 30},
+		},
+		{
+			name: "years and months",
+			at:   census1851,
+			age:  &Age{Y: 45, M: 2, Unit: AgeMonths},
+			want: &BetweenPrecise{StartYear: 1805, StartMonth: 12, StartDay: 31, EndYear: 1806, EndMonth: 1, EndDay: 30},
+		},
+		{
+			name: "days",
+			at:   census1851,
+			age:  &Age{Y: 45, M: 2, D: 3, Unit: AgeDays},
+			want: &Precise{Y: 1806, M: 1, D: 27},
+		},
+		{
+			name: "weeks",
+			at:   census1851,
+			age:  &Age{W: 3, Unit: AgeWeeks},
+			want: &BetweenPrecise{StartYear: 1851, StartMonth: 3, StartDay: 3, EndYear: 1851, EndMonth: 3, EndDay: 9},
+		},
+		{
+			name: "year of record",
+			at:   &Year{Y: 1851},
+			age:  &Age{Y: 45},
+			want: &BetweenPrecise{StartYear: 1805, StartMonth: 1, StartDay: 2, EndYear: 1806, EndMonth: 12, EndDay: 31},
+		},
+		{
+			name: "about",
+			at:   census1851,
+			age:  &Age{Y: 45, Qualifier: AgeAbout},
+			want: &BetweenPrecise{StartYear: 1803, StartMonth: 3, StartDay: 31, EndYear: 1808, EndMonth: 3, EndDay: 30},
+		},
+		{
+			name: "under",
+			at:   census1851,
+			age:  &Age{Y: 1, Qualifier: AgeUnder},
+			want: &BetweenPrecise{StartYear: 1850, StartMonth: 3, StartDay: 31, EndYear: 1851, EndMonth: 3, EndDay: 30},
+		},
+		{
+			name: "over",
+			at:   census1851,
+			age:  &Age{Y: 21, Qualifier: AgeOver},
+			want: &BeforePrecise{Y: 1830, M: 3, D: 31},
+		},
+		{
+			name: "1841 census rounded",
+			at:   census1841,
+			age:  &Age{Y: 45, Convention: AgeCensus1841},
+			want: &BetweenPrecise{StartYear: 1791, StartMonth: 6, StartDay: 7, EndYear: 1796, EndMonth: 6, EndDay: 6},
+		},
+		{
+			name: "1841 census exact",
+			at:   census1841,
+			age:  &Age{Y: 47, Convention: AgeCensus1841},
+			want: &BetweenPrecise{StartYear: 1793, StartMonth: 6, StartDay: 7, EndYear: 1794, EndMonth: 6, EndDay: 6},
+		},
+		{
+			name: "1841 census child",
+			at:   census1841,
+			age:  &Age{Y: 10, Convention: AgeCensus1841},
+			want: &BetweenPrecise{StartYear: 1830, StartMonth: 6, StartDay: 7, EndYear: 1831, EndMonth: 6, EndDay: 6},
+		},
+		{
+			name: "old style year of record",
+			at:   &Year{C: Julian25Mar, Y: 1700},
+			age:  &Age{Y: 45},
+			want: &BetweenPrecise{C: Julian25Mar, StartYear: 1654, StartMonth: 3, StartDay: 26, EndYear: 1655, EndMonth: 3, EndDay: 24},
+		},
+		{
+			name: "old style day of record",
+			at:   &Precise{C: Julian25Mar, Y: 1700, M: 2, D: 10},
+			age:  &Age{Y: 45},
+			want: &BetweenPrecise{C: Julian25Mar, StartYear: 1654, StartMonth: 2, StartDay: 11, EndYear: 1655, EndMonth: 2, EndDay: 10},
+		},
+		{
+			name: "unknown",
+			at:   &Unknown{},
+			age:  &Age{Y: 45},
+			want: &Unknown{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, BirthDate(tc.at, tc.age)); diff != "" {
+				t.Errorf("BirthDate(%s, %s) mismatch (-want +got):\n%s", tc.at, tc.age, diff)
+			}
+		})
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	testCases := []struct {
+		s      string
+		want   Interval
+		gedcom string
+	}{
+		{s: "45y 3m 2d", want: &PreciseInterval{Y: 45, M: 3, D: 2}, gedcom: "45y 3m 2d"},
+		{s: "45y", want: &YearsInterval{Y: 45}, gedcom: "45y"},
+		{s: "4m", want: &PreciseInterval{M: 4}, gedcom: "4m"},
+		{s: "aged 4 months", want: &PreciseInterval{M: 4}, gedcom: "4m"},
+		{s: "2w 1d", want: &PreciseInterval{D: 15}, gedcom: "15d"},
+		{s: "0y", want: &YearsInterval{}, gedcom: "0y"},
+		{s: "> 45y", want: &GreaterThanInterval{In: &YearsInterval{Y: 45}}, gedcom: "> 45y"},
+		{s: "<1y", want: &LessThanInterval{In: &YearsInterval{Y: 1}}, gedcom: "< 1y"},
+		{s: "< 6m 2d", want: &LessThanInterval{In: &PreciseInterval{M: 6, D: 2}}, gedcom: "< 6m 2d"},
+		{s: "CHILD", want: &KeywordInterval{K: AgeChild}, gedcom: "CHILD"},
+		{s: "INFANT", want: &KeywordInterval{K: AgeInfant}, gedcom: "INFANT"},
+		{s: "stillborn", want: &KeywordInterval{K: AgeStillborn}, gedcom: "STILLBORN"},
+		{s: "about 45", want: &AboutYearsInterval{Y: 45}},
+		{s: "about 6 months"},
+		{s: "45x"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := ParseInterval(tc.s)
+			if tc.want == nil {
+				if err == nil {
+					t.Fatalf("got %v, wanted error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseInterval(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+			ged, ok := GedcomAge(got)
+			if ok != (tc.gedcom != "") {
+				t.Fatalf("got GedcomAge ok=%v, want %v", ok, tc.gedcom != "")
+			}
+			if ged != tc.gedcom {
+				t.Errorf("got GedcomAge=%q, want %q", ged, tc.gedcom)
+			}
+		})
+	}
+}
+
+func TestAgeOf(t *testing.T) {
+	testCases := []struct {
+		in   Interval
+		want *Age
+	}{
+		{in: &YearsInterval{Y: 45}, want: &Age{Y: 45}},
+		{in: &PreciseInterval{Y: 45, M: 3}, want: &Age{Y: 45, M: 3, Unit: AgeMonths}},
+		{in: &PreciseInterval{D: 15}, want: &Age{D: 15, Unit: AgeDays}},
+		{in: &AboutYearsInterval{Y: 45}, want: &Age{Y: 45, Qualifier: AgeAbout}},
+		{in: &LessThanInterval{In: &YearsInterval{Y: 1}}, want: &Age{Y: 1, Qualifier: AgeUnder}},
+		{in: &GreaterThanInterval{In: &YearsInterval{Y: 45}}, want: &Age{Y: 45, Qualifier: AgeOver}},
+		{in: &KeywordInterval{K: AgeChild}, want: &Age{Y: 8, Qualifier: AgeUnder}},
+		{in: &KeywordInterval{K: AgeStillborn}, want: &Age{Unit: AgeDays}},
+		{in: &LessThanInterval{In: &AboutYearsInterval{Y: 1}}},
+		{in: &UnknownInterval{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.in.Precise(), func(t *testing.T) {
+			got, ok := AgeOf(tc.in)
+			if ok != (tc.want != nil) {
+				t.Fatalf("got ok=%v, want %v", ok, tc.want != nil)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AgeOf(%s) mismatch (-want +got):\n%s", tc.in.Precise(), diff)
+			}
+		})
+	}
+}
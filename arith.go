@@ -0,0 +1,177 @@
+package gdate
+
+import "math"
+
+// Add returns the date that is the interval in after d, in the calendar of d, such as 8 Mar 1850 for
+// 5 Mar 1850 plus three days. Adding an AboutYearsInterval makes the date approximate, so 5 Mar 1806 plus
+// about 45 years is "abt. 1851", while a range of years is widened by the About window of DefaultBounds.
+// The result is an Unknown date if d or the interval is unknown.
+func Add(d Date, in Interval) Date {
+	return DefaultBounds.Add(d, in)
+}
+
+// Sub returns the date that is the interval in before d, in the calendar of d, such as "abt. 1806" for
+// about 45 years before 30 Mar 1851. It is the counterpart of Add.
+func Sub(d Date, in Interval) Date {
+	return DefaultBounds.Sub(d, in)
+}
+
+// Add returns the date that is the interval in after d, in the calendar of d. Years and months are added
+// before days, and a day that does not exist in the resulting month, such as 31 Feb, becomes the last
+// day of the month. The uncertainty of the date is kept: a range is moved as a whole, so Mar 1850 plus
+// one month is Apr 1850, and the day of a date before or after a day is moved. Adding an AboutYearsInterval
+// gives an AboutYear when the moved date falls within a single year. Otherwise the moved date is widened
+// by the About window of the policy, so a range of years grows by the window at each end and the day of a
+// date before or after a day moves later or earlier by the window. The dates in a set and the start and
+// end of a period are each moved. The result is an Unknown date if d or the interval is unknown.
+func (p BoundsPolicy) Add(d Date, in Interval) Date {
+	y, m, dd, about, ok := intervalParts(in)
+	if !ok {
+		return &Unknown{C: calendarOf(d, nil)}
+	}
+	return p.add(d, y, m, dd, about)
+}
+
+// Sub returns the date that is the interval in before d, in the calendar of d. It is the counterpart of Add.
+func (p BoundsPolicy) Sub(d Date, in Interval) Date {
+	y, m, dd, about, ok := intervalParts(in)
+	if !ok {
+		return &Unknown{C: calendarOf(d, nil)}
+	}
+	return p.add(d, -y, -m, -dd, about)
+}
+
+// add moves d by y years, m months and dd days, making it approximate if about is true
+func (p BoundsPolicy) add(d Date, y, m, dd int, about bool) Date {
+	switch td := d.(type) {
+	case nil:
+		return &Unknown{}
+	case *Unknown:
+		return &Unknown{C: td.C}
+	case *OneOf:
+		return &OneOf{Dates: p.addAll(td.Dates, y, m, dd, about)}
+	case *AllOf:
+		return &AllOf{Dates: p.addAll(td.Dates, y, m, dd, about)}
+	case *Period:
+		res := &Period{}
+		if td.Start != nil {
+			res.Start = p.add(td.Start, y, m, dd, about)
+		}
+		if td.End != nil {
+			res.End = p.add(td.End, y, m, dd, about)
+		}
+		return res
+	case *AboutYear:
+		return &AboutYear{C: td.C, Y: shiftYear(td.C, td.Y, y, m, dd)}
+	case *EstimatedYear:
+		if about {
+			return &AboutYear{C: td.C, Y: shiftYear(td.C, td.Y, y, m, dd)}
+		}
+		return &EstimatedYear{C: td.C, Y: shiftYear(td.C, td.Y, y, m, dd)}
+	case qualifiedDay:
+		c, after := d.Calendar(), td.after()
+		jd := shiftDay(c, td.julianDay(), y, m, dd)
+		if after {
+			jd = shiftEndDay(c, td.julianDay(), y, m, dd)
+		}
+		switch {
+		case !about:
+			return fromQualifiedDay(c, jd, after)
+		case p.About == Unbounded:
+			return &Unknown{C: c}
+		case after:
+			return fromQualifiedDay(c, shiftEndDay(c, jd, -p.About, 0, 0), true)
+		}
+		return fromQualifiedDay(c, shiftDay(c, jd, p.About, 0, 0), false)
+	case ComparableDate:
+		c := d.Calendar()
+		lo, hi := td.EarliestJulianDay(), td.LatestJulianDay()
+		if lo == hi {
+			lo = shiftDay(c, lo, y, m, dd)
+			hi = lo
+		} else {
+			lo, hi = shiftDay(c, lo, y, m, dd), shiftEndDay(c, hi, y, m, dd)
+			hi = max(hi, lo)
+		}
+		if !about {
+			return fromBounds(c, lo, hi)
+		}
+		y1, _, _ := c.FromJulianDay(lo)
+		y2, _, _ := c.FromJulianDay(hi)
+		if y1 == y2 {
+			return &AboutYear{C: c, Y: y1}
+		}
+		lo, hi = yearWindow(c, y1, p.About)
+		if hi != math.MaxInt {
+			_, hi = yearWindow(c, y2, p.About)
+		}
+		return fromOpenBounds(c, lo, hi)
+	}
+	return &Unknown{C: d.Calendar()}
+}
+
+// addAll moves each date in a set
+func (p BoundsPolicy) addAll(dates []Date, y, m, dd int, about bool) []Date {
+	res := make([]Date, len(dates))
+	for i, d := range dates {
+		res[i] = p.add(d, y, m, dd, about)
+	}
+	return res
+}
+
+// intervalParts returns the years, months and days of an interval, and whether the number of years is
+// approximate. It returns false if the interval is unknown.
+func intervalParts(in Interval) (int, int, int, bool, bool) {
+	if ai, ok := in.(*AboutYearsInterval); ok {
+		return ai.Y, 0, 0, true, true
+	}
+	if pi, ok := AsPreciseInterval(in); ok {
+		return pi.Y, pi.M, pi.D, false, true
+	}
+	if yi, ok := AsYearsInterval(in); ok {
+		return yi.Y, 0, 0, false, true
+	}
+	return 0, 0, 0, false, false
+}
+
+// shiftDay returns the Julian day y years, m months and d days after the day jd in calendar c. Years and
+// months are added first, and a day that does not exist in the resulting month, such as 31 Feb, becomes
+// the last day of the month.
+func shiftDay(c Calendar, jd, y, m, d int) int {
+	c = reckoning(c)
+	y0, m0, d0 := c.FromJulianDay(jd)
+	months := y0*12 + m0 - 1 + y*12 + m
+	ny := months / 12
+	if months < 0 && months%12 != 0 {
+		ny--
+	}
+	nm := months - ny*12 + 1
+	return c.JulianDay(ny, nm, min(d0, c.DaysInMonth(ny, nm))) + d
+}
+
+// shiftEndDay moves the day jd that ends a date, such as the last day of a month, like shiftDay except
+// that the last day of a month remains the last day of a month, so 30 Apr plus one month is 31 May.
+func shiftEndDay(c Calendar, jd, y, m, d int) int {
+	c = reckoning(c)
+	y0, m0, d0 := c.FromJulianDay(jd)
+	if d0 != c.DaysInMonth(y0, m0) {
+		return shiftDay(c, jd, y, m, d)
+	}
+	return shiftDay(c, jd+1, y, m, 0) - 1 + d
+}
+
+// shiftYear returns the year in which the first day of year yr falls after it is moved by y years,
+// m months and d days
+func shiftYear(c Calendar, yr, y, m, d int) int {
+	ny, _, _ := c.FromJulianDay(shiftDay(c, yearStart(c, yr), y, m, d))
+	return ny
+}
+
+// reckoning returns the calendar in which months are counted for c. Months in the Julian25Mar calendar
+// follow the Julian calendar, whose years begin in January.
+func reckoning(c Calendar) Calendar {
+	if c == Julian25Mar {
+		return Julian
+	}
+	return c
+}
@@ -0,0 +1,71 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		d    Date
+		in   Interval
+		want Date
+	}{
+		{d: &Precise{Y: 1850, M: 3, D: 5}, in: &PreciseInterval{D: 3}, want: &Precise{Y: 1850, M: 3, D: 8}},
+		{d: &Precise{Y: 1850, M: 12, D: 30}, in: &PreciseInterval{D: 3}, want: &Precise{Y: 1851, M: 1, D: 2}},
+		{d: &Precise{Y: 1850, M: 1, D: 31}, in: &PreciseInterval{M: 1}, want: &Precise{Y: 1850, M: 2, D: 28}},
+		{d: &Precise{Y: 1852, M: 2, D: 29}, in: &YearsInterval{Y: 1}, want: &Precise{Y: 1853, M: 2, D: 28}},
+		{d: &Precise{Y: 1850, M: 3, D: 5}, in: &PreciseInterval{Y: 1, M: 10, D: 27}, want: &Precise{Y: 1852, M: 2, D: 1}},
+		{d: &Precise{C: Julian25Mar, Y: 1700, M: 12, D: 15}, in: &PreciseInterval{M: 1}, want: &Precise{C: Julian25Mar, Y: 1700, M: 1, D: 15}},
+		{d: &Precise{Y: 1806, M: 3, D: 5}, in: &AboutYearsInterval{Y: 45}, want: &AboutYear{Y: 1851}},
+		{d: &MonthYear{Y: 1850, M: 3}, in: &PreciseInterval{M: 1}, want: &MonthYear{Y: 1850, M: 4}},
+		{d: &MonthYear{Y: 1850, M: 4}, in: &PreciseInterval{M: 1}, want: &MonthYear{Y: 1850, M: 5}},
+		{d: &Year{Y: 1850}, in: &YearsInterval{Y: 10}, want: &Year{Y: 1860}},
+		{d: &Year{Y: 1850}, in: &PreciseInterval{D: 3}, want: &BetweenPrecise{StartYear: 1850, StartMonth: 1, StartDay: 4, EndYear: 1851, EndMonth: 1, EndDay: 3}},
+		{d: &Year{Y: 1850}, in: &AboutYearsInterval{Y: 10}, want: &AboutYear{Y: 1860}},
+		{d: &YearRange{Lower: 1850, Upper: 1852}, in: &AboutYearsInterval{Y: 10}, want: &YearRange{Lower: 1858, Upper: 1864}},
+		{d: &AboutYear{Y: 1850}, in: &YearsInterval{Y: 10}, want: &AboutYear{Y: 1860}},
+		{d: &EstimatedYear{Y: 1850}, in: &YearsInterval{Y: 10}, want: &EstimatedYear{Y: 1860}},
+		{d: &EstimatedYear{Y: 1850}, in: &AboutYearsInterval{Y: 10}, want: &AboutYear{Y: 1860}},
+		{d: &BeforeYear{Y: 1850}, in: &YearsInterval{Y: 10}, want: &BeforeYear{Y: 1860}},
+		{d: &AfterMonthYear{Y: 1850, M: 4}, in: &PreciseInterval{M: 1}, want: &AfterMonthYear{Y: 1850, M: 5}},
+		{d: &BeforeYear{Y: 1850}, in: &AboutYearsInterval{Y: 10}, want: &BeforeYear{Y: 1862}},
+		{d: &AfterYear{Y: 1850}, in: &AboutYearsInterval{Y: 10}, want: &AfterYear{Y: 1858}},
+		{d: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}}, in: &YearsInterval{Y: 1}, want: &OneOf{Dates: []Date{&Year{Y: 1851}, &Year{Y: 1852}}}},
+		{d: &Period{Start: &Year{Y: 1850}}, in: &YearsInterval{Y: 1}, want: &Period{Start: &Year{Y: 1851}}},
+		{d: &Unknown{Text: "not a date"}, in: &YearsInterval{Y: 1}, want: &Unknown{}},
+		{d: &Year{Y: 1850}, in: &UnknownInterval{}, want: &Unknown{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String()+" "+tc.in.Precise(), func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Add(tc.d, tc.in)); diff != "" {
+				t.Errorf("Add(%s, %s) mismatch (-want +got):\n%s", tc.d, tc.in.Precise(), diff)
+			}
+		})
+	}
+}
+
+func TestSub(t *testing.T) {
+	testCases := []struct {
+		d    Date
+		in   Interval
+		want Date
+	}{
+		{d: &Precise{Y: 1851, M: 3, D: 30}, in: &AboutYearsInterval{Y: 45}, want: &AboutYear{Y: 1806}},
+		{d: &Precise{Y: 1851, M: 3, D: 30}, in: &YearsInterval{Y: 45}, want: &Precise{Y: 1806, M: 3, D: 30}},
+		{d: &Precise{Y: 1850, M: 1, D: 2}, in: &PreciseInterval{D: 3}, want: &Precise{Y: 1849, M: 12, D: 30}},
+		{d: &Precise{Y: 1850, M: 3, D: 31}, in: &PreciseInterval{M: 1}, want: &Precise{Y: 1850, M: 2, D: 28}},
+		{d: &MonthYear{Y: 1850, M: 1}, in: &PreciseInterval{M: 2}, want: &MonthYear{Y: 1849, M: 11}},
+		{d: &YearQuarter{Y: 1850, Q: 2}, in: &PreciseInterval{M: 3}, want: &YearQuarter{Y: 1850, Q: 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String()+" "+tc.in.Precise(), func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Sub(tc.d, tc.in)); diff != "" {
+				t.Errorf("Sub(%s, %s) mismatch (-want +got):\n%s", tc.d, tc.in.Precise(), diff)
+			}
+		})
+	}
+}
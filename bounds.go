@@ -0,0 +1,112 @@
+package gdate
+
+import "math"
+
+// Unbounded is a window that leaves the end of a date open, so that it has no earliest or latest day.
+const Unbounded = -1
+
+// A BoundsPolicy gives earliest and latest days to dates that are open ended or approximate, such as
+// "bef. 1850", "aft. 5 Mar 1850" or "abt. 1850", so that every type of date can take part in range
+// queries and comparisons. Each window is a number of years, or Unbounded to leave the date open.
+// Dates with bounds of their own, such as Precise or Year, are unaffected by the policy.
+type BoundsPolicy struct {
+	// About is the number of years either side of the year of an AboutYear in which the date may fall,
+	// so a window of 2 gives "abt. 1850" the bounds 1 Jan 1848 and 31 Dec 1852.
+	About int
+
+	// Estimated is the number of years either side of the year of an EstimatedYear in which the date may fall.
+	Estimated int
+
+	// Before is the number of years before the day of a date such as BeforeYear or BeforePrecise in which the
+	// date may fall, so a window of 10 gives "bef. 1850" the bounds 1 Jan 1840 and 31 Dec 1849.
+	Before int
+
+	// After is the number of years after the day of a date such as AfterYear or AfterPrecise in which the
+	// date may fall.
+	After int
+}
+
+// DefaultBounds is a policy suited to genealogical records: about and estimated dates fall within two years
+// of their year, and before and after dates within ten years of their day.
+var DefaultBounds = BoundsPolicy{About: 2, Estimated: 2, Before: 10, After: 10}
+
+// OpenBounds is a policy that leaves open ended and approximate dates without bounds.
+var OpenBounds = BoundsPolicy{About: Unbounded, Estimated: Unbounded, Before: Unbounded, After: Unbounded}
+
+// Bounds returns the earliest and latest Julian days on which d could fall. An open end is given as
+// math.MinInt for the earliest day or math.MaxInt for the latest day. Unknown dates, and periods
+// with an open start or end, are always open. A year in the Julian25Mar calendar runs from 25 Mar of the
+// year as written to 24 Mar of the following year of the Julian calendar.
+func (p BoundsPolicy) Bounds(d Date) (int, int) {
+	switch td := d.(type) {
+	case *OneOf:
+		return p.setBounds(td.Dates)
+	case *AllOf:
+		return p.setBounds(td.Dates)
+	case *Period:
+		lo, hi := math.MinInt, math.MaxInt
+		if td.Start != nil {
+			lo, _ = p.Bounds(td.Start)
+		}
+		if td.End != nil {
+			_, hi = p.Bounds(td.End)
+		}
+		return lo, hi
+	case *AboutYear:
+		return yearWindow(td.C, td.Y, p.About)
+	case *EstimatedYear:
+		return yearWindow(td.C, td.Y, p.Estimated)
+	case qualifiedDay:
+		c, jd := d.Calendar(), td.julianDay()
+		if td.after() {
+			if p.After == Unbounded {
+				return jd + 1, math.MaxInt
+			}
+			return jd + 1, shiftDay(c, jd, p.After, 0, 0)
+		}
+		if p.Before == Unbounded {
+			return math.MinInt, jd - 1
+		}
+		return shiftDay(c, jd, -p.Before, 0, 0), jd - 1
+	case ComparableDate:
+		return td.EarliestJulianDay(), td.LatestJulianDay()
+	}
+	return math.MinInt, math.MaxInt
+}
+
+// EarliestJulianDay returns the earliest Julian day on which d could fall, or math.MinInt if it has no
+// earliest day.
+func (p BoundsPolicy) EarliestJulianDay(d Date) int {
+	lo, _ := p.Bounds(d)
+	return lo
+}
+
+// LatestJulianDay returns the latest Julian day on which d could fall, or math.MaxInt if it has no
+// latest day.
+func (p BoundsPolicy) LatestJulianDay(d Date) int {
+	_, hi := p.Bounds(d)
+	return hi
+}
+
+// setBounds returns the earliest and latest days of any date in a set. The set is open at the start or end
+// if any of its dates is, and a set with no dates is open at both.
+func (p BoundsPolicy) setBounds(dates []Date) (int, int) {
+	lo, hi := math.MaxInt, math.MinInt
+	for _, d := range dates {
+		dlo, dhi := p.Bounds(d)
+		lo, hi = min(lo, dlo), max(hi, dhi)
+	}
+	if lo > hi {
+		return math.MinInt, math.MaxInt
+	}
+	return lo, hi
+}
+
+// yearWindow returns the first day of the year n years before y and the last day of the year n years
+// after y, or open bounds if n is Unbounded.
+func yearWindow(c Calendar, y, n int) (int, int) {
+	if n == Unbounded {
+		return math.MinInt, math.MaxInt
+	}
+	return yearStart(c, y-n), yearEnd(c, y+n)
+}
@@ -0,0 +1,99 @@
+package gdate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoundsPolicy(t *testing.T) {
+	jd := func(y, m, d int) int { return Gregorian.JulianDay(y, m, d) }
+
+	testCases := []struct {
+		p        BoundsPolicy
+		d        Date
+		earliest int
+		latest   int
+	}{
+		{p: DefaultBounds, d: &AboutYear{Y: 1850}, earliest: jd(1848, 1, 1), latest: jd(1852, 12, 31)},
+		{p: DefaultBounds, d: &EstimatedYear{Y: 1850}, earliest: jd(1848, 1, 1), latest: jd(1852, 12, 31)},
+		{p: DefaultBounds, d: &BeforeYear{Y: 1850}, earliest: jd(1840, 1, 1), latest: jd(1849, 12, 31)},
+		{p: DefaultBounds, d: &AfterYear{Y: 1850}, earliest: jd(1851, 1, 1), latest: jd(1860, 12, 31)},
+		{p: DefaultBounds, d: &BeforePrecise{Y: 1852, M: 2, D: 29}, earliest: jd(1842, 2, 28), latest: jd(1852, 2, 28)},
+		{p: DefaultBounds, d: &AfterPrecise{Y: 1850, M: 3, D: 5}, earliest: jd(1850, 3, 6), latest: jd(1860, 3, 5)},
+		{p: DefaultBounds, d: &BeforeMonthYear{Y: 1850, M: 3}, earliest: jd(1840, 3, 1), latest: jd(1850, 2, 28)},
+		{p: DefaultBounds, d: &AfterQuarter{Y: 1850, Q: 2}, earliest: jd(1850, 7, 1), latest: jd(1860, 6, 30)},
+		{p: DefaultBounds, d: &Precise{Y: 1850, M: 3, D: 5}, earliest: jd(1850, 3, 5), latest: jd(1850, 3, 5)},
+		{p: DefaultBounds, d: &OneOf{Dates: []Date{&AboutYear{Y: 1850}, &Year{Y: 1860}}}, earliest: jd(1848, 1, 1), latest: jd(1860, 12, 31)},
+		{p: DefaultBounds, d: &Period{Start: &BeforeYear{Y: 1850}, End: &Year{Y: 1860}}, earliest: jd(1840, 1, 1), latest: jd(1860, 12, 31)},
+		{p: DefaultBounds, d: &Period{Start: &Year{Y: 1871}}, earliest: jd(1871, 1, 1), latest: math.MaxInt},
+		{p: DefaultBounds, d: &Unknown{}, earliest: math.MinInt, latest: math.MaxInt},
+		{p: BoundsPolicy{About: 5, Before: 1}, d: &AboutYear{Y: 1850}, earliest: jd(1845, 1, 1), latest: jd(1855, 12, 31)},
+		{p: BoundsPolicy{About: 5, Before: 1}, d: &BeforeYear{Y: 1850}, earliest: jd(1849, 1, 1), latest: jd(1849, 12, 31)},
+		{p: OpenBounds, d: &AboutYear{Y: 1850}, earliest: math.MinInt, latest: math.MaxInt},
+		{p: OpenBounds, d: &BeforeYear{Y: 1850}, earliest: math.MinInt, latest: jd(1849, 12, 31)},
+		{p: OpenBounds, d: &AfterYear{Y: 1850}, earliest: jd(1851, 1, 1), latest: math.MaxInt},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			if got := tc.p.EarliestJulianDay(tc.d); got != tc.earliest {
+				t.Errorf("got earliest %d, want %d", got, tc.earliest)
+			}
+			if got := tc.p.LatestJulianDay(tc.d); got != tc.latest {
+				t.Errorf("got latest %d, want %d", got, tc.latest)
+			}
+		})
+	}
+}
+
+func TestBoundsJulian25Mar(t *testing.T) {
+	// Years in the Julian25Mar calendar run from 25 Mar to 24 Mar of the following year of the Julian calendar
+	jd := func(y, m, d int) int { return Julian.JulianDay(y, m, d) }
+	c := Julian25Mar
+
+	testCases := []struct {
+		p        BoundsPolicy
+		d        Date
+		earliest int
+		latest   int
+	}{
+		{p: OpenBounds, d: &Year{C: c, Y: 1700}, earliest: jd(1700, 3, 25), latest: jd(1701, 3, 24)},
+		{p: OpenBounds, d: &YearRange{C: c, Lower: 1700, Upper: 1702}, earliest: jd(1700, 3, 25), latest: jd(1703, 3, 24)},
+		{p: OpenBounds, d: &Decade{C: c, Y: 1700}, earliest: jd(1700, 3, 25), latest: jd(1710, 3, 24)},
+		{p: OpenBounds, d: &MonthYear{C: c, Y: 1700, M: 4}, earliest: jd(1700, 4, 1), latest: jd(1700, 4, 30)},
+		{p: OpenBounds, d: &MonthYear{C: c, Y: 1700, M: 1}, earliest: jd(1701, 1, 1), latest: jd(1701, 1, 31)},
+		{p: OpenBounds, d: &MonthYear{C: c, Y: 1700, M: 3}, earliest: jd(1700, 3, 25), latest: jd(1701, 3, 24)},
+		{p: OpenBounds, d: &YearQuarter{C: c, Y: 1700, Q: 3}, earliest: jd(1700, 7, 1), latest: jd(1700, 9, 30)},
+		{p: OpenBounds, d: &YearQuarter{C: c, Y: 1700, Q: 1}, earliest: jd(1700, 3, 25), latest: jd(1701, 3, 24)},
+		{p: OpenBounds, d: &BeforeYear{C: c, Y: 1700}, earliest: math.MinInt, latest: jd(1700, 3, 24)},
+		{p: OpenBounds, d: &AfterYear{C: c, Y: 1700}, earliest: jd(1701, 3, 25), latest: math.MaxInt},
+		{p: OpenBounds, d: &BeforeMonthYear{C: c, Y: 1700, M: 2}, earliest: math.MinInt, latest: jd(1701, 1, 31)},
+		{p: DefaultBounds, d: &AboutYear{C: c, Y: 1700}, earliest: jd(1698, 3, 25), latest: jd(1703, 3, 24)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			if got := tc.p.EarliestJulianDay(tc.d); got != tc.earliest {
+				t.Errorf("got earliest %d, want %d", got, tc.earliest)
+			}
+			if got := tc.p.LatestJulianDay(tc.d); got != tc.latest {
+				t.Errorf("got latest %d, want %d", got, tc.latest)
+			}
+		})
+	}
+}
+
+func TestBoundsPolicyComparison(t *testing.T) {
+	if got := DefaultBounds.Relate(&AboutYear{Y: 1850}, &Year{Y: 1850}); got != RelationContains {
+		t.Errorf("got Relate=%s, want %s", got, RelationContains)
+	}
+	if got := DefaultBounds.IsBefore(&AboutYear{Y: 1850}, &Year{Y: 1860}); got != Definitely {
+		t.Errorf("got IsBefore=%s, want %s", got, Definitely)
+	}
+	if got := DefaultBounds.IsAfter(&BeforeYear{Y: 1850}, &Year{Y: 1830}); got != Definitely {
+		t.Errorf("got IsAfter=%s, want %s", got, Definitely)
+	}
+	if got := IsAfter(&BeforeYear{Y: 1850}, &Year{Y: 1830}); got != Possibly {
+		t.Errorf("got IsAfter with open bounds=%s, want %s", got, Possibly)
+	}
+}
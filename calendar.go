@@ -41,6 +41,52 @@ func (c Calendar) JulianDay(y, m, d int) int {
 	}
 }
 
+// FromJulianDay returns the year, month and day in the calendar of the day with the given Julian Day Number.
+// It is the inverse of JulianDay. Years for dates in the Julian25Mar calendar are numbered with the year
+// starting on 25 Mar, so the day before 25 Mar 1700 is 24 Mar 1699.
+func (c Calendar) FromJulianDay(jd int) (int, int, int) {
+	switch c {
+	case Gregorian:
+		a := jd + 32044
+		b := (4*a + 3) / 146097
+		c := a - 146097*b/4
+		d := (4*c + 3) / 1461
+		e := c - 1461*d/4
+		m := (5*e + 2) / 153
+		return 100*b + d - 4800 + m/10, m + 3 - 12*(m/10), e - (153*m+2)/5 + 1
+	case Julian, Julian25Mar:
+		b := jd + 32082
+		d := (4*b + 3) / 1461
+		e := b - 1461*d/4
+		m := (5*e + 2) / 153
+		yr, mo, dy := d-4800+m/10, m+3-12*(m/10), e-(153*m+2)/5+1
+		if c == Julian25Mar && (mo == 1 || mo == 2 || (mo == 3 && dy < 25)) {
+			yr--
+		}
+		return yr, mo, dy
+	default:
+		panic("unsupported calendar: " + strconv.Itoa(int(c)))
+	}
+}
+
+// DaysInMonth returns the number of days in month m of year y. For the Julian25Mar calendar
+// y is the year as written, so February 1699 falls in the leap year 1700.
+func (c Calendar) DaysInMonth(y, m int) int {
+	switch m {
+	case 4, 6, 9, 11:
+		return 30
+	case 2:
+		if c == Julian25Mar {
+			y++
+		}
+		if y%4 == 0 && (c != Gregorian || y%100 != 0 || y%400 == 0) {
+			return 29
+		}
+		return 28
+	}
+	return 31
+}
+
 // FmtYear formats the year as a string according to the calendar convention.
 // The Julian25Mar calendar returns years of the form 1650/51 for dates
 // before March 25th, showing the OS year and the last two digits of the NS year.
@@ -84,6 +130,21 @@ const (
 	ReckoningLocationIreland         ReckoningLocation = 3
 )
 
+func (r ReckoningLocation) String() string {
+	switch r {
+	case ReckoningLocationNone:
+		return "no location"
+	case ReckoningLocationEnglandAndWales:
+		return "England and Wales"
+	case ReckoningLocationScotland:
+		return "Scotland"
+	case ReckoningLocationIreland:
+		return "Ireland"
+	default:
+		return "unknown reckoning location (" + strconv.Itoa(int(r)) + ")"
+	}
+}
+
 // StartOfYear returns calendar in use for the year specified.
 func (r ReckoningLocation) Calendar(y int) Calendar {
 	switch r {
@@ -245,3 +245,59 @@ func TestCalendarJulianDay(t *testing.T) {
 		})
 	}
 }
+
+func TestCalendarFromJulianDay(t *testing.T) {
+	testCases := []struct {
+		c       Calendar
+		y, m, d int
+	}{
+		{c: Gregorian, y: 1582, m: 10, d: 15},
+		{c: Gregorian, y: 1900, m: 2, d: 28},
+		{c: Gregorian, y: 2000, m: 2, d: 29},
+		{c: Gregorian, y: 1850, m: 12, d: 31},
+		{c: Julian, y: 125, m: 4, d: 24},
+		{c: Julian, y: 1700, m: 2, d: 29},
+		{c: Julian, y: 1752, m: 3, d: 24},
+		{c: Julian25Mar, y: 1650, m: 3, d: 10},
+		{c: Julian25Mar, y: 1650, m: 3, d: 25},
+		{c: Julian25Mar, y: 1699, m: 2, d: 29},
+		{c: Julian25Mar, y: 1750, m: 12, d: 31},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s_%02d_%02d_%04d", tc.c, tc.d, tc.m, tc.y), func(t *testing.T) {
+			y, m, d := tc.c.FromJulianDay(tc.c.JulianDay(tc.y, tc.m, tc.d))
+			if y != tc.y || m != tc.m || d != tc.d {
+				t.Errorf("got %04d-%02d-%02d, want %04d-%02d-%02d", y, m, d, tc.y, tc.m, tc.d)
+			}
+		})
+	}
+}
+
+func TestCalendarDaysInMonth(t *testing.T) {
+	testCases := []struct {
+		c    Calendar
+		y, m int
+		want int
+	}{
+		{c: Gregorian, y: 1850, m: 1, want: 31},
+		{c: Gregorian, y: 1850, m: 4, want: 30},
+		{c: Gregorian, y: 1850, m: 2, want: 28},
+		{c: Gregorian, y: 1852, m: 2, want: 29},
+		{c: Gregorian, y: 1900, m: 2, want: 28},
+		{c: Gregorian, y: 2000, m: 2, want: 29},
+		{c: Julian, y: 1700, m: 2, want: 29},
+		{c: Julian, y: 1701, m: 2, want: 28},
+		{c: Julian25Mar, y: 1699, m: 2, want: 29},
+		{c: Julian25Mar, y: 1700, m: 2, want: 28},
+		{c: Julian25Mar, y: 1700, m: 12, want: 31},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s_%02d_%04d", tc.c, tc.m, tc.y), func(t *testing.T) {
+			if got := tc.c.DaysInMonth(tc.y, tc.m); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gdate
+
+import "math"
+
+// Intersect returns the narrowest date consistent with both a and b, as when two sources give "bef. 1860"
+// and "aft. 1855" for the same event, which intersect to 1856-1859. It returns false if the dates
+// contradict each other. Open ended and approximate dates have no bounds, so "abt. 1857" places no
+// constraint on the 1850s. Use the Intersect method of a BoundsPolicy to narrow dates by approximate ones.
+func Intersect(a, b Date) (Date, bool) {
+	return OpenBounds.Intersect(a, b)
+}
+
+// Union returns the narrowest date that includes both a and b, such as 1850-1855 for 1850 and 1855. The
+// union is an Unknown date if it has neither an earliest nor a latest day.
+func Union(a, b Date) Date {
+	return OpenBounds.Union(a, b)
+}
+
+// Intersect returns the narrowest date consistent with both a and b, using the bounds given to each date by
+// the policy, or false if the dates contradict each other. The result is the most specific type of date that
+// has the intersecting bounds, as returned by Normalize. A date with neither an earliest nor a latest day
+// places no constraint on the other date, which is returned in its canonical form. The dates in a OneOf are
+// intersected separately and those that contradict the other date are removed.
+func (p BoundsPolicy) Intersect(a, b Date) (Date, bool) {
+	if _, ok := b.(*OneOf); ok {
+		a, b = b, a
+	}
+	if o, ok := a.(*OneOf); ok {
+		var dates []Date
+		for _, d := range o.Dates {
+			if id, ok := p.Intersect(d, b); ok {
+				dates = append(dates, id)
+			}
+		}
+		if len(dates) == 0 {
+			return nil, false
+		}
+		return Normalize(&OneOf{Dates: dates}), true
+	}
+
+	a1, a2 := p.Bounds(a)
+	b1, b2 := p.Bounds(b)
+	switch {
+	case b1 == math.MinInt && b2 == math.MaxInt:
+		return Normalize(a), true
+	case a1 == math.MinInt && a2 == math.MaxInt:
+		return Normalize(b), true
+	}
+	lo, hi := max(a1, b1), min(a2, b2)
+	if lo > hi {
+		return nil, false
+	}
+	return fromOpenBounds(calendarOf(a, b), lo, hi), true
+}
+
+// Union returns the narrowest date that includes both a and b, using the bounds given to each date by the
+// policy. The result is the most specific type of date that has the combined bounds, as returned by
+// Normalize, or an Unknown date if it has neither an earliest nor a latest day.
+func (p BoundsPolicy) Union(a, b Date) Date {
+	a1, a2 := p.Bounds(a)
+	b1, b2 := p.Bounds(b)
+	return fromOpenBounds(calendarOf(a, b), min(a1, b1), max(a2, b2))
+}
+
+// calendarOf returns the calendar of a, or of b if a is nil
+func calendarOf(a, b Date) Calendar {
+	switch {
+	case a != nil:
+		return a.Calendar()
+	case b != nil:
+		return b.Calendar()
+	}
+	return Gregorian
+}
+
+// fromOpenBounds returns the most specific date in calendar c that falls between the Julian days lo and hi,
+// either of which may be open. A date with an open start is before the day after hi, and one with an open
+// end is after the day before lo.
+func fromOpenBounds(c Calendar, lo, hi int) Date {
+	switch {
+	case lo == math.MinInt && hi == math.MaxInt:
+		return &Unknown{C: c}
+	case lo == math.MinInt:
+		return fromQualifiedDay(c, hi+1, false)
+	case hi == math.MaxInt:
+		return fromQualifiedDay(c, lo-1, true)
+	}
+	return fromBounds(c, lo, hi)
+}
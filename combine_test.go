@@ -0,0 +1,138 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIntersect(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    BoundsPolicy
+		a, b Date
+		want Date // nil if the dates contradict each other
+	}{
+		{
+			name: "before and after",
+			p:    OpenBounds,
+			a:    &BeforeYear{Y: 1860},
+			b:    &AfterYear{Y: 1855},
+			want: &YearRange{Lower: 1856, Upper: 1859},
+		},
+		{
+			name: "decade and about",
+			p:    DefaultBounds,
+			a:    &Decade{Y: 1850},
+			b:    &AboutYear{Y: 1857},
+			want: &YearRange{Lower: 1855, Upper: 1859},
+		},
+		{
+			name: "decade and open about",
+			p:    OpenBounds,
+			a:    &Decade{Y: 1850},
+			b:    &AboutYear{Y: 1857},
+			want: &Decade{Y: 1850},
+		},
+		{
+			name: "year and month",
+			p:    OpenBounds,
+			a:    &Year{Y: 1850},
+			b:    &MonthYearRange{LowerYear: 1849, LowerMonth: 11, UpperYear: 1850, UpperMonth: 3},
+			want: &YearQuarter{Y: 1850, Q: 1},
+		},
+		{
+			name: "before and before",
+			p:    OpenBounds,
+			a:    &BeforeYear{Y: 1860},
+			b:    &BeforePrecise{Y: 1855, M: 3, D: 5},
+			want: &BeforePrecise{Y: 1855, M: 3, D: 5},
+		},
+		{
+			name: "after and year",
+			p:    OpenBounds,
+			a:    &AfterMonthYear{Y: 1850, M: 6},
+			b:    &Year{Y: 1850},
+			want: &MonthYearRange{LowerYear: 1850, LowerMonth: 7, UpperYear: 1850, UpperMonth: 12},
+		},
+		{
+			name: "one of",
+			p:    OpenBounds,
+			a:    &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1852}}},
+			b:    &AfterYear{Y: 1850},
+			want: &Year{Y: 1852},
+		},
+		{
+			name: "unknown",
+			p:    OpenBounds,
+			a:    &Unknown{Text: "not a date"},
+			b:    &YearRange{Lower: 1850, Upper: 1850},
+			want: &Year{Y: 1850},
+		},
+		{
+			name: "old style year and month",
+			p:    OpenBounds,
+			a:    &Year{C: Julian25Mar, Y: 1700},
+			b:    &AfterMonthYear{C: Julian25Mar, Y: 1700, M: 11},
+			want: &BetweenPrecise{C: Julian25Mar, StartYear: 1700, StartMonth: 12, StartDay: 1, EndYear: 1700, EndMonth: 3, EndDay: 24},
+		},
+		{
+			name: "old style year and itself",
+			p:    OpenBounds,
+			a:    &Year{C: Julian25Mar, Y: 1700},
+			b:    &Year{C: Julian25Mar, Y: 1700},
+			want: &Year{C: Julian25Mar, Y: 1700},
+		},
+		{
+			name: "old style years",
+			p:    OpenBounds,
+			a:    &Year{C: Julian25Mar, Y: 1700},
+			b:    &Year{C: Julian25Mar, Y: 1701},
+		},
+		{
+			name: "contradiction",
+			p:    OpenBounds,
+			a:    &BeforeYear{Y: 1850},
+			b:    &AfterYear{Y: 1855},
+		},
+		{
+			name: "one of contradiction",
+			p:    OpenBounds,
+			a:    &Year{Y: 1851},
+			b:    &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1852}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.p.Intersect(tc.a, tc.b)
+			if ok != (tc.want != nil) {
+				t.Fatalf("got ok=%v, want %v", ok, tc.want != nil)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Intersect(%s, %s) mismatch (-want +got):\n%s", tc.a, tc.b, diff)
+			}
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want Date
+	}{
+		{a: &Year{Y: 1850}, b: &Year{Y: 1855}, want: &YearRange{Lower: 1850, Upper: 1855}},
+		{a: &MonthYear{Y: 1850, M: 1}, b: &MonthYear{Y: 1850, M: 3}, want: &YearQuarter{Y: 1850, Q: 1}},
+		{a: &Precise{Y: 1850, M: 3, D: 5}, b: &BeforeYear{Y: 1850}, want: &BeforePrecise{Y: 1850, M: 3, D: 6}},
+		{a: &AfterYear{Y: 1850}, b: &Year{Y: 1849}, want: &AfterYear{Y: 1848}},
+		{a: &BeforeYear{Y: 1850}, b: &AfterYear{Y: 1860}, want: &Unknown{}},
+		{a: &Year{C: Julian25Mar, Y: 1700}, b: &Year{C: Julian25Mar, Y: 1701}, want: &YearRange{C: Julian25Mar, Lower: 1700, Upper: 1701}},
+		{a: &MonthYear{C: Julian25Mar, Y: 1700, M: 12}, b: &MonthYear{C: Julian25Mar, Y: 1700, M: 1}, want: &MonthYearRange{C: Julian25Mar, LowerYear: 1700, LowerMonth: 12, UpperYear: 1700, UpperMonth: 1}},
+	}
+
+	for _, tc := range testCases {
+		if diff := cmp.Diff(tc.want, Union(tc.a, tc.b)); diff != "" {
+			t.Errorf("Union(%s, %s) mismatch (-want +got):\n%s", tc.a, tc.b, diff)
+		}
+	}
+}
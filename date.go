@@ -2,7 +2,9 @@ package gdate
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"time"
 )
 
 type Date interface {
@@ -18,31 +20,79 @@ type ComparableDate interface {
 	LatestJulianDay() int
 }
 
-// SortsBefore reports whether a should sort before b chronologically
+// SortsBefore reports whether a should sort before b chronologically. Every date is ordered by a single
+// sort key, so SortsBefore is a strict weak ordering over all types of date and may be used with sort.Slice.
+//
+// Dates sort by the first day they could fall on. Dates that start on the same day are ordered with dates
+// after an earlier day first, then dates before that day, then dates with bounds. Among dates with bounds
+// the larger ranges sort before the ranges they might contain and dates near a year, such as "abt. 1850",
+// sort after the year itself. Unknown dates sort after every other date, ordered by their text.
 func SortsBefore(a, b Date) bool {
 	if a == nil || b == nil {
 		return false
 	}
+	return sortKeyOf(a).less(sortKeyOf(b))
+}
 
-	if ca, ok := a.(ComparableDate); ok {
-		if cb, ok := b.(ComparableDate); ok {
-			if ca.EarliestJulianDay() != cb.EarliestJulianDay() {
-				return ca.EarliestJulianDay() < cb.EarliestJulianDay()
-			}
-			// larger ranges sort before ranges they might contain
-			return ca.LatestJulianDay() > cb.LatestJulianDay()
-		}
-	}
+// Phases order dates that sort on the same day
+const (
+	phaseAfter   = 0 // dates after the previous day, such as "aft. 1849"
+	phaseBefore  = 1 // dates before the day, such as "bef. 1850"
+	phaseBounded = 2 // dates with bounds that start on the day
+	phaseUnknown = 3 // unknown dates
+)
 
-	if s, ok := a.(interface{ SortsBefore(Date) bool }); ok {
-		return s.SortsBefore(b)
+// A sortKey determines the position of a date in the chronological ordering. Keys are compared field by
+// field.
+type sortKey struct {
+	day   int    // the first day on which the date could fall
+	phase int    // orders dates that sort on the same day
+	span  int    // the negated last day of a date with bounds, so larger ranges sort first
+	near  bool   // the date is near to a year rather than within it, as with AboutYear
+	text  string // the text of an unknown date
+}
+
+func (k sortKey) less(o sortKey) bool {
+	switch {
+	case k.phase == phaseUnknown || o.phase == phaseUnknown:
+		if k.phase != o.phase {
+			return k.phase < o.phase
+		}
+		return k.text < o.text
+	case k.day != o.day:
+		return k.day < o.day
+	case k.phase != o.phase:
+		return k.phase < o.phase
+	case k.span != o.span:
+		return k.span < o.span
 	}
+	return !k.near && o.near
+}
 
-	if s, ok := b.(interface{ SortsBefore(Date) bool }); ok {
-		return !s.SortsBefore(a)
+// sortKeyOf returns the key by which d sorts
+func sortKeyOf(d Date) sortKey {
+	switch td := d.(type) {
+	case *Unknown:
+		return sortKey{phase: phaseUnknown, text: td.Text}
+	case *Period:
+		return td.sortKey()
+	case qualifiedDay:
+		if td.after() {
+			return sortKey{day: td.julianDay() + 1, phase: phaseAfter}
+		}
+		return sortKey{day: td.julianDay(), phase: phaseBefore}
+	case ComparableDate:
+		if td.EarliestJulianDay() == math.MinInt {
+			// A set with a date that has no earliest day
+			return sortKey{phase: phaseUnknown}
+		}
+		return sortKey{day: td.EarliestJulianDay(), phase: phaseBounded, span: -td.LatestJulianDay()}
+	case interface{ Year() int }:
+		// Dates near to a year, such as AboutYear and EstimatedYear
+		c, y := d.Calendar(), td.Year()
+		return sortKey{day: yearStart(c, y), phase: phaseBounded, span: -yearEnd(c, y), near: true}
 	}
-
-	return false
+	return sortKey{phase: phaseUnknown}
 }
 
 // AsYear returns the date as a Year and true if possible, false if it is not possible to convert.
@@ -88,11 +138,7 @@ func (u *Unknown) Occurrence() string {
 }
 
 func (u *Unknown) SortsBefore(d Date) bool {
-	switch td := d.(type) {
-	case *Unknown:
-		return u.Text < td.Text
-	}
-	return false
+	return SortsBefore(u, d)
 }
 
 func (u *Unknown) Calendar() Calendar {
@@ -135,6 +181,11 @@ func (p *Precise) Calendar() Calendar {
 	return p.C
 }
 
+// Weekday returns the day of the week on which the date fell, taking account of its calendar.
+func (p *Precise) Weekday() time.Weekday {
+	return weekdayOf(p.EarliestJulianDay())
+}
+
 func (p *Precise) EarliestJulianDay() int {
 	return p.C.JulianDay(p.Y, p.M, p.D)
 }
@@ -167,11 +218,11 @@ func (y *Year) Calendar() Calendar {
 }
 
 func (y *Year) EarliestJulianDay() int {
-	return y.C.JulianDay(y.Y, 1, 1)
+	return yearStart(y.C, y.Y)
 }
 
 func (y *Year) LatestJulianDay() int {
-	return y.C.JulianDay(y.Y, 12, 31)
+	return yearEnd(y.C, y.Y)
 }
 
 // Year is a date for which only the month and year is known or a period of time that may span an entire month.
@@ -204,14 +255,47 @@ func (m *MonthYear) Calendar() Calendar {
 }
 
 func (m *MonthYear) EarliestJulianDay() int {
-	return m.C.JulianDay(m.Y, m.M, 1)
+	lo, _ := monthSpan(m.C, m.Y, m.M, m.M)
+	return lo
 }
 
 func (m *MonthYear) LatestJulianDay() int {
-	if m.M < 12 {
-		return m.C.JulianDay(m.Y, m.M+1, 1) - 1
+	_, hi := monthSpan(m.C, m.Y, m.M, m.M)
+	return hi
+}
+
+// lastJulianDayOfMonth returns the Julian day of the last day of month m in year y of calendar c.
+func lastJulianDayOfMonth(c Calendar, y, m int) int {
+	if m < 12 {
+		return c.JulianDay(y, m+1, 1) - 1
+	}
+	return c.JulianDay(y, m, 31)
+}
+
+// yearStart returns the Julian day of the first day of year y as written in calendar c, which is 25 Mar
+// in the Julian25Mar calendar.
+func yearStart(c Calendar, y int) int {
+	if c == Julian25Mar {
+		return c.JulianDay(y, 3, 25)
 	}
-	return m.C.JulianDay(m.Y, m.M, 31)
+	return c.JulianDay(y, 1, 1)
+}
+
+// yearEnd returns the Julian day of the last day of year y as written in calendar c, which is 24 Mar of
+// the following year of the Julian calendar in the Julian25Mar calendar.
+func yearEnd(c Calendar, y int) int {
+	return yearStart(c, y+1) - 1
+}
+
+// monthSpan returns the first and last Julian days of months first to last of year y as written in
+// calendar c. In the Julian25Mar calendar the days of Jan, Feb and Mar before the 25th fall at the end of
+// the year as written, so months that include days on both sides of 25 Mar span the whole year.
+func monthSpan(c Calendar, y, first, last int) (int, int) {
+	lo, hi := c.JulianDay(y, first, 1), lastJulianDayOfMonth(c, y, last)
+	if c == Julian25Mar && lo > hi {
+		return yearStart(c, y), yearEnd(c, y)
+	}
+	return lo, hi
 }
 
 // BeforePrecise represents a date that is before a specific day.
@@ -232,20 +316,10 @@ func (b *BeforePrecise) Occurrence() string {
 
 func (b *BeforePrecise) julianDay() int { return b.C.JulianDay(b.Y, b.M, b.D) }
 
+func (b *BeforePrecise) after() bool { return false }
+
 func (b *BeforePrecise) SortsBefore(d Date) bool {
-	if cd, ok := d.(ComparableDate); ok {
-		return b.julianDay() <= cd.EarliestJulianDay()
-	}
-	if by, ok := d.(*BeforeYear); ok {
-		return b.Y < by.Y
-	}
-	if ay, ok := d.(*AfterYear); ok {
-		return b.Y <= ay.Y
-	}
-	if _, ok := d.(*Unknown); ok {
-		return true
-	}
-	return false
+	return SortsBefore(b, d)
 }
 
 func (b *BeforePrecise) Calendar() Calendar { return b.C }
@@ -268,24 +342,135 @@ func (a *AfterPrecise) Occurrence() string {
 
 func (a *AfterPrecise) julianDay() int { return a.C.JulianDay(a.Y, a.M, a.D) }
 
+func (a *AfterPrecise) after() bool { return true }
+
 func (a *AfterPrecise) SortsBefore(d Date) bool {
-	if cd, ok := d.(ComparableDate); ok {
-		return a.julianDay() < cd.EarliestJulianDay()
-	}
-	if by, ok := d.(*BeforeYear); ok {
-		return a.Y < by.Y
-	}
-	if ay, ok := d.(*AfterYear); ok {
-		return a.Y < ay.Y
-	}
-	if _, ok := d.(*Unknown); ok {
-		return true
-	}
-	return false
+	return SortsBefore(a, d)
 }
 
 func (a *AfterPrecise) Calendar() Calendar { return a.C }
 
+// BeforeMonthYear represents a date that is before the start of a specific month.
+type BeforeMonthYear struct {
+	C Calendar
+	Y int
+	M int
+}
+
+func (b *BeforeMonthYear) String() string {
+	return fmt.Sprintf("bef. %s %04d", shortMonthNames[b.M], b.Y)
+}
+
+func (b *BeforeMonthYear) Occurrence() string {
+	return fmt.Sprintf("before %s %04d", shortMonthNames[b.M], b.Y)
+}
+
+func (b *BeforeMonthYear) julianDay() int {
+	lo, _ := monthSpan(b.C, b.Y, b.M, b.M)
+	return lo
+}
+
+func (b *BeforeMonthYear) after() bool { return false }
+
+func (b *BeforeMonthYear) SortsBefore(d Date) bool {
+	return SortsBefore(b, d)
+}
+
+func (b *BeforeMonthYear) Calendar() Calendar { return b.C }
+
+// AfterMonthYear represents a date that is after the end of a specific month.
+type AfterMonthYear struct {
+	C Calendar
+	Y int
+	M int
+}
+
+func (a *AfterMonthYear) String() string {
+	return fmt.Sprintf("aft. %s %04d", shortMonthNames[a.M], a.Y)
+}
+
+func (a *AfterMonthYear) Occurrence() string {
+	return fmt.Sprintf("after %s %04d", shortMonthNames[a.M], a.Y)
+}
+
+func (a *AfterMonthYear) julianDay() int {
+	_, hi := monthSpan(a.C, a.Y, a.M, a.M)
+	return hi
+}
+
+func (a *AfterMonthYear) after() bool { return true }
+
+func (a *AfterMonthYear) SortsBefore(d Date) bool {
+	return SortsBefore(a, d)
+}
+
+func (a *AfterMonthYear) Calendar() Calendar { return a.C }
+
+// BeforeQuarter represents a date that is before the start of a quarter of a year, such as
+// "before the Dec quarter 1850".
+type BeforeQuarter struct {
+	C Calendar
+	Y int
+	Q int
+}
+
+func (b *BeforeQuarter) quarter() *YearQuarter { return &YearQuarter{C: b.C, Y: b.Y, Q: b.Q} }
+
+func (b *BeforeQuarter) String() string {
+	return "bef. " + b.quarter().String()
+}
+
+func (b *BeforeQuarter) Occurrence() string {
+	return fmt.Sprintf("before the %s quarter of %04d", b.quarter().MonthRange(), b.Y)
+}
+
+func (b *BeforeQuarter) julianDay() int { return b.quarter().EarliestJulianDay() }
+
+func (b *BeforeQuarter) after() bool { return false }
+
+func (b *BeforeQuarter) SortsBefore(d Date) bool {
+	return SortsBefore(b, d)
+}
+
+func (b *BeforeQuarter) Calendar() Calendar { return b.C }
+
+// AfterQuarter represents a date that is after the end of a quarter of a year, such as
+// "after Jun qtr 1850".
+type AfterQuarter struct {
+	C Calendar
+	Y int
+	Q int
+}
+
+func (a *AfterQuarter) quarter() *YearQuarter { return &YearQuarter{C: a.C, Y: a.Y, Q: a.Q} }
+
+func (a *AfterQuarter) String() string {
+	return "aft. " + a.quarter().String()
+}
+
+func (a *AfterQuarter) Occurrence() string {
+	return fmt.Sprintf("after the %s quarter of %04d", a.quarter().MonthRange(), a.Y)
+}
+
+func (a *AfterQuarter) julianDay() int { return a.quarter().LatestJulianDay() }
+
+func (a *AfterQuarter) after() bool { return true }
+
+func (a *AfterQuarter) SortsBefore(d Date) bool {
+	return SortsBefore(a, d)
+}
+
+func (a *AfterQuarter) Calendar() Calendar { return a.C }
+
+// A qualifiedDay is a date that is known only to be before or after a specific day, such as
+// BeforeYear or AfterPrecise.
+type qualifiedDay interface {
+	// julianDay returns the day that the date is before or after
+	julianDay() int
+	// after reports whether the date is after the day rather than before it
+	after() bool
+}
+
 // BeforeYear represents a date that is before the start of a specific year.
 // It sorts before any date with that year.
 type BeforeYear struct {
@@ -302,31 +487,13 @@ func (b *BeforeYear) Occurrence() string {
 }
 
 func (b *BeforeYear) SortsBefore(d Date) bool {
-	switch td := d.(type) {
-	case *Precise:
-		return b.Y <= td.Y
-	case *Year:
-		return b.Y <= td.Y
-	case *BeforeYear:
-		return b.Y < td.Y
-	case *AfterYear:
-		return b.Y <= td.Y
-	case *AboutYear:
-		return b.Y <= td.Y
-	case *YearQuarter:
-		return b.Y <= td.Y
-	case *EstimatedYear:
-		return b.Y <= td.Y
-	case *MonthYear:
-		return b.Y <= td.Y
-	case *YearRange:
-		return b.Y < td.Lower
-	case *Unknown:
-		return true
-	}
-	return false
+	return SortsBefore(b, d)
 }
 
+func (b *BeforeYear) julianDay() int { return yearStart(b.C, b.Y) }
+
+func (b *BeforeYear) after() bool { return false }
+
 func (b *BeforeYear) Calendar() Calendar {
 	return b.C
 }
@@ -346,31 +513,13 @@ func (a *AfterYear) Occurrence() string {
 }
 
 func (a *AfterYear) SortsBefore(d Date) bool {
-	switch td := d.(type) {
-	case *Precise:
-		return a.Y < td.Y
-	case *Year:
-		return a.Y < td.Y
-	case *BeforeYear:
-		return a.Y < td.Y
-	case *AfterYear:
-		return a.Y < td.Y
-	case *AboutYear:
-		return a.Y < td.Y
-	case *YearQuarter:
-		return a.Y < td.Y
-	case *EstimatedYear:
-		return a.Y < td.Y
-	case *MonthYear:
-		return a.Y < td.Y
-	case *YearRange:
-		return a.Y < td.Lower
-	case *Unknown:
-		return true
-	}
-	return false
+	return SortsBefore(a, d)
 }
 
+func (a *AfterYear) julianDay() int { return yearEnd(a.C, a.Y) }
+
+func (a *AfterYear) after() bool { return true }
+
 func (a *AfterYear) Calendar() Calendar {
 	return a.C
 }
@@ -390,29 +539,7 @@ func (a *AboutYear) Occurrence() string {
 }
 
 func (a *AboutYear) SortsBefore(d Date) bool {
-	switch td := d.(type) {
-	case *Precise:
-		return a.Y <= td.Y
-	case *Year:
-		return a.Y < td.Y
-	case *BeforeYear:
-		return a.Y < td.Y
-	case *AfterYear:
-		return a.Y <= td.Y
-	case *AboutYear:
-		return a.Y < td.Y
-	case *YearQuarter:
-		return a.Y <= td.Y
-	case *EstimatedYear:
-		return a.Y < td.Y
-	case *MonthYear:
-		return a.Y < td.Y
-	case *YearRange:
-		return a.Y < td.Lower
-	case *Unknown:
-		return true
-	}
-	return false
+	return SortsBefore(a, d)
 }
 
 func (a *AboutYear) Year() int {
@@ -466,15 +593,13 @@ func (y *YearQuarter) Calendar() Calendar {
 }
 
 func (y *YearQuarter) EarliestJulianDay() int {
-	return y.C.JulianDay(y.Y, 1+(y.Q-1)*3, 1)
+	lo, _ := monthSpan(y.C, y.Y, 1+(y.Q-1)*3, 3+(y.Q-1)*3)
+	return lo
 }
 
 func (y *YearQuarter) LatestJulianDay() int {
-	d := 31
-	if y.Q == 2 {
-		d = 30
-	}
-	return y.C.JulianDay(y.Y, 3+(y.Q-1)*3, d)
+	_, hi := monthSpan(y.C, y.Y, 1+(y.Q-1)*3, 3+(y.Q-1)*3)
+	return hi
 }
 
 // EstimatedYear represents a date that is estimated to be a specific year
@@ -492,29 +617,7 @@ func (e *EstimatedYear) Occurrence() string {
 }
 
 func (e *EstimatedYear) SortsBefore(d Date) bool {
-	switch td := d.(type) {
-	case *Precise:
-		return e.Y <= td.Y
-	case *Year:
-		return e.Y < td.Y
-	case *BeforeYear:
-		return e.Y < td.Y
-	case *AfterYear:
-		return e.Y <= td.Y
-	case *AboutYear:
-		return e.Y < td.Y
-	case *YearQuarter:
-		return e.Y <= td.Y
-	case *EstimatedYear:
-		return e.Y < td.Y
-	case *MonthYear:
-		return e.Y < td.Y
-	case *YearRange:
-		return e.Y < td.Lower
-	case *Unknown:
-		return true
-	}
-	return false
+	return SortsBefore(e, d)
 }
 
 func (e *EstimatedYear) Year() int {
@@ -610,14 +713,13 @@ func (m *MonthYearRange) Calendar() Calendar {
 }
 
 func (m *MonthYearRange) EarliestJulianDay() int {
-	return m.C.JulianDay(m.LowerYear, m.LowerMonth, 1)
+	lo, _ := monthSpan(m.C, m.LowerYear, m.LowerMonth, m.LowerMonth)
+	return lo
 }
 
 func (m *MonthYearRange) LatestJulianDay() int {
-	if m.UpperMonth < 12 {
-		return m.C.JulianDay(m.UpperYear, m.UpperMonth+1, 1) - 1
-	}
-	return m.C.JulianDay(m.UpperYear, m.UpperMonth, 31)
+	_, hi := monthSpan(m.C, m.UpperYear, m.UpperMonth, m.UpperMonth)
+	return hi
 }
 
 // YearRange represents a date that is within the range of two years, including the upper and lower year.
@@ -646,9 +748,9 @@ func (y *YearRange) Calendar() Calendar {
 }
 
 func (y *YearRange) EarliestJulianDay() int {
-	return y.C.JulianDay(y.Lower, 1, 1)
+	return yearStart(y.C, y.Lower)
 }
 
 func (y *YearRange) LatestJulianDay() int {
-	return y.C.JulianDay(y.Upper, 12, 31)
+	return yearEnd(y.C, y.Upper)
 }
@@ -1,7 +1,9 @@
 package gdate
 
 import (
+	"math/rand/v2"
 	"testing"
+	"time"
 )
 
 func TestSortsBefore(t *testing.T) {
@@ -165,6 +167,7 @@ func TestSortsBefore(t *testing.T) {
 				&YearQuarter{Y: 1845, Q: 3},
 				&YearQuarter{Y: 1845, Q: 4},
 				&EstimatedYear{Y: 1846},
+				&MonthYear{Y: 1845, M: 12},
 				&MonthYear{Y: 1846, M: 1},
 				&YearRange{Lower: 1850, Upper: 1860},
 			},
@@ -178,7 +181,6 @@ func TestSortsBefore(t *testing.T) {
 				&Year{Y: 1844},
 				&EstimatedYear{Y: 1845},
 				&EstimatedYear{Y: 1844},
-				&MonthYear{Y: 1845, M: 12},
 				&YearRange{Lower: 1840, Upper: 1850},
 			},
 		},
@@ -363,6 +365,116 @@ func TestSortsBefore(t *testing.T) {
 				&YearRange{Lower: 1839, Upper: 1850},
 			},
 		},
+		{
+			date: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}},
+			before: []Date{
+				&Year{Y: 1850},
+				&Year{Y: 1851},
+				&Precise{Y: 1850, M: 1, D: 1},
+				&AfterYear{Y: 1850},
+				&AboutYear{Y: 1851},
+				&EstimatedYear{Y: 1851},
+				&AfterPrecise{Y: 1850, M: 1, D: 1},
+				&AboutYear{Y: 1850},
+				&EstimatedYear{Y: 1850},
+				&Period{Start: &Year{Y: 1851}},
+				&Period{End: &Year{Y: 1860}},
+				&Unknown{},
+			},
+			notBefore: []Date{
+				&Precise{Y: 1849, M: 12, D: 31},
+				&BeforeYear{Y: 1850},
+				&BeforePrecise{Y: 1850, M: 1, D: 1},
+				&YearRange{Lower: 1840, Upper: 1860},
+				&Period{Start: &Year{Y: 1849}},
+				&Period{End: &Year{Y: 1850}},
+			},
+		},
+		{
+			date: &BeforeMonthYear{Y: 1850, M: 3},
+			before: []Date{
+				&Precise{Y: 1850, M: 3, D: 1},
+				&MonthYear{Y: 1850, M: 3},
+				&BeforeMonthYear{Y: 1850, M: 4},
+				&BeforeQuarter{Y: 1850, Q: 2},
+				&BeforeYear{Y: 1851},
+				&AfterYear{Y: 1850},
+				&AboutYear{Y: 1851},
+				&Decade{Y: 1850, Part: PartMid},
+				&Unknown{},
+			},
+			notBefore: []Date{
+				&Precise{Y: 1850, M: 2, D: 28},
+				&MonthYear{Y: 1850, M: 2},
+				&BeforeMonthYear{Y: 1850, M: 3},
+				&AfterMonthYear{Y: 1850, M: 2},
+				&BeforeYear{Y: 1850},
+				&AfterYear{Y: 1849},
+				&BeforeQuarter{Y: 1850, Q: 1},
+				&AboutYear{Y: 1849},
+				&Decade{Y: 1850},
+			},
+		},
+		{
+			date: &AfterQuarter{Y: 1850, Q: 2},
+			before: []Date{
+				&Precise{Y: 1850, M: 7, D: 1},
+				&YearQuarter{Y: 1850, Q: 3},
+				&AfterMonthYear{Y: 1850, M: 7},
+				&BeforeQuarter{Y: 1850, Q: 3},
+				&BeforeYear{Y: 1851},
+				&AfterYear{Y: 1850},
+				&Unknown{},
+			},
+			notBefore: []Date{
+				&Precise{Y: 1850, M: 6, D: 30},
+				&YearQuarter{Y: 1850, Q: 2},
+				&AfterMonthYear{Y: 1850, M: 6},
+				&BeforeYear{Y: 1850},
+				&AboutYear{Y: 1850},
+			},
+		},
+		{
+			date: &BeforeYear{Y: 1850},
+			before: []Date{
+				&Decade{Y: 1850},
+				&BeforeMonthYear{Y: 1850, M: 3},
+				&Partial{Y: 1850, M: 3},
+			},
+			notBefore: []Date{
+				&Decade{Y: 1840},
+				&AfterQuarter{Y: 1849, Q: 4},
+				&AfterMonthYear{Y: 1849, M: 11},
+			},
+		},
+		{
+			date: &AboutYear{Y: 1850},
+			before: []Date{
+				&Decade{Y: 1851},
+				&AfterMonthYear{Y: 1850, M: 3},
+				&BeforeMonthYear{Y: 1851, M: 3},
+				&Season{Y: 1850, S: SeasonSpring},
+			},
+			notBefore: []Date{
+				&Year{Y: 1850},
+				&AfterMonthYear{Y: 1849, M: 12},
+			},
+		},
+		{
+			date: &Period{End: &Year{Y: 1860}},
+			before: []Date{
+				&Year{Y: 1860},
+				&Precise{Y: 1860, M: 1, D: 1},
+				&AfterYear{Y: 1860},
+				&Period{Start: &Year{Y: 1860}},
+				&Unknown{},
+			},
+			notBefore: []Date{
+				&Precise{Y: 1859, M: 12, D: 31},
+				&BeforeYear{Y: 1860},
+				&Period{Start: &Year{Y: 1859}},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -399,6 +511,112 @@ func TestSortsBefore(t *testing.T) {
 	}
 }
 
+// randomDate returns a date of a random type. Years are drawn from a narrow range so that dates often
+// overlap or share bounds.
+func randomDate(r *rand.Rand) Date {
+	c := Calendar(r.IntN(3))
+	y := 1848 + r.IntN(4)
+	m := 1 + r.IntN(12)
+	d := 1 + r.IntN(c.DaysInMonth(y, m))
+	q := 1 + r.IntN(4)
+	switch r.IntN(27) {
+	case 0:
+		return &Precise{C: c, Y: y, M: m, D: d}
+	case 1:
+		return &Year{C: c, Y: y}
+	case 2:
+		return &MonthYear{C: c, Y: y, M: m}
+	case 3:
+		return &YearQuarter{C: c, Y: y, Q: q}
+	case 4:
+		return &YearRange{C: c, Lower: y, Upper: y + r.IntN(3)}
+	case 5:
+		return &MonthYearRange{C: c, LowerYear: y, LowerMonth: m, UpperYear: y + 1, UpperMonth: 1 + r.IntN(12)}
+	case 6:
+		return &BetweenPrecise{C: c, StartYear: y, StartMonth: m, StartDay: d, EndYear: y + 1, EndMonth: m, EndDay: 1}
+	case 7:
+		return &BeforeYear{C: c, Y: y}
+	case 8:
+		return &AfterYear{C: c, Y: y}
+	case 9:
+		return &AboutYear{C: c, Y: y}
+	case 10:
+		return &EstimatedYear{C: c, Y: y}
+	case 11:
+		return &BeforePrecise{C: c, Y: y, M: m, D: d}
+	case 12:
+		return &AfterPrecise{C: c, Y: y, M: m, D: d}
+	case 13:
+		return &BeforeMonthYear{C: c, Y: y, M: m}
+	case 14:
+		return &AfterMonthYear{C: c, Y: y, M: m}
+	case 15:
+		return &BeforeQuarter{C: c, Y: y, Q: q}
+	case 16:
+		return &AfterQuarter{C: c, Y: y, Q: q}
+	case 17:
+		return &Decade{C: c, Y: 1840 + 10*r.IntN(2), Part: Part(r.IntN(4))}
+	case 18:
+		return &YearPart{C: c, Y: y, Part: Part(1 + r.IntN(3))}
+	case 19:
+		return &Season{C: c, Y: y, S: SeasonKind(1 + r.IntN(4))}
+	case 20:
+		return &QuarterDay{C: c, Y: y, Q: q}
+	case 21:
+		return &Partial{C: c, Y: 1840 + 10*r.IntN(2), M: m, UnknownYearDigits: 1, NoDay: true}
+	case 22:
+		return &OneOf{Dates: []Date{&Year{C: c, Y: y}, &Precise{C: c, Y: y + 1, M: m, D: d}}}
+	case 23:
+		return &AllOf{Dates: []Date{&MonthYear{C: c, Y: y, M: m}, &Year{C: c, Y: y + 1}}}
+	case 24:
+		return &Period{Start: &Year{C: c, Y: y}, End: &Year{C: c, Y: y + r.IntN(3)}}
+	case 25:
+		if r.IntN(2) == 0 {
+			return &Period{Start: &MonthYear{C: c, Y: y, M: m}}
+		}
+		return &Period{End: &MonthYear{C: c, Y: y, M: m}}
+	}
+	return &Unknown{Text: string(rune('a' + r.IntN(3)))}
+}
+
+// TestSortsBeforeStrictWeakOrdering checks that SortsBefore is irreflexive, asymmetric and transitive, and that
+// dates that do not sort before one another are equivalent, across randomly generated dates of every type.
+func TestSortsBeforeStrictWeakOrdering(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	dates := make([]Date, 150)
+	for i := range dates {
+		dates[i] = randomDate(r)
+	}
+
+	before := make([][]bool, len(dates))
+	for i, a := range dates {
+		before[i] = make([]bool, len(dates))
+		for j, b := range dates {
+			before[i][j] = SortsBefore(a, b)
+		}
+	}
+	equiv := func(i, j int) bool { return !before[i][j] && !before[j][i] }
+
+	for i, a := range dates {
+		if before[i][i] {
+			t.Errorf("irreflexivity: SortsBefore(%q,%q)=true", a, a)
+		}
+		for j, b := range dates {
+			if before[i][j] && before[j][i] {
+				t.Errorf("asymmetry: SortsBefore(%q,%q) and SortsBefore(%q,%q) are both true", a, b, b, a)
+			}
+			for k, c := range dates {
+				if before[i][j] && before[j][k] && !before[i][k] {
+					t.Errorf("transitivity: %q sorts before %q and %q sorts before %q but not %q", a, b, b, c, c)
+				}
+				if equiv(i, j) && equiv(j, k) && !equiv(i, k) {
+					t.Errorf("transitivity of equivalence: %q is equivalent to %q and %q to %q but not %q", a, b, b, c, c)
+				}
+			}
+		}
+	}
+}
+
 func TestSortsBeforeWithStartOfYear(t *testing.T) {
 	testCases := []struct {
 		date      Date
@@ -471,3 +689,24 @@ func TestSortsBeforeWithStartOfYear(t *testing.T) {
 		})
 	}
 }
+
+func TestPreciseWeekday(t *testing.T) {
+	testCases := []struct {
+		date *Precise
+		want time.Weekday
+	}{
+		{date: &Precise{Y: 1850, M: 3, D: 5, C: Gregorian}, want: time.Tuesday},
+		{date: &Precise{Y: 1752, M: 9, D: 14, C: Gregorian}, want: time.Thursday},
+		{date: &Precise{Y: 1752, M: 9, D: 2, C: Julian}, want: time.Wednesday},
+		{date: &Precise{Y: 1612, M: 4, D: 12, C: Julian}, want: time.Sunday},
+		{date: &Precise{Y: 1700, M: 1, D: 1, C: Julian25Mar}, want: time.Wednesday}, // 1 Jan 1701 in the Julian calendar
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date.String(), func(t *testing.T) {
+			if got := tc.date.Weekday(); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package gdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A ParseError describes why a string could not be parsed as a date.
+type ParseError struct {
+	Input      string // the text that was parsed
+	Pos        int    // byte offset in Input of the first text that could not be interpreted
+	Expected   string // a description of what was expected at Pos, such as "a month name"
+	Nearest    string // the recognised form most similar to the input, such as "D MMM YYYY"
+	Suggestion string // a corrected version of the input that can be parsed, or empty if none was found
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot parse %q as a date: expected %s at offset %d", e.Input, e.Expected, e.Pos)
+	if e.Nearest != "" {
+		fmt.Fprintf(&b, " (nearest form is %s)", e.Nearest)
+	}
+	if e.Suggestion != "" {
+		fmt.Fprintf(&b, ", did you mean %q?", e.Suggestion)
+	}
+	return b.String()
+}
+
+// Diagnose parses s in the same way as ParseWithWarnings but explains failures instead of
+// silently returning an Unknown date. Text following a recognisable date is ignored with a
+// warning. If no date can be found, an Unknown date is returned with an error of type *ParseError
+// describing the problem.
+func (p *Parser) Diagnose(s string) (Date, []Warning, error) {
+	st := newParseState()
+	d := p.parseInput(s, st)
+
+	toks := tokenize(s)
+	if IsUnknown(d) {
+		// Look for the longest sequence of leading tokens that can be parsed
+		for i := len(toks) - 1; i > 0; i-- {
+			prefix := strings.TrimRightFunc(s[:toks[i].pos], isSeparator)
+			if prefix == "" {
+				break
+			}
+			pst := newParseState()
+			pd := p.parseInput(prefix, pst)
+			if !IsUnknown(pd) {
+				d = pd
+				st.merge(pst)
+				st.warn(WarningTrailingText, "ignored trailing text %q", strings.TrimSpace(s[len(prefix):]))
+				break
+			}
+		}
+	}
+
+	if IsUnknown(d) {
+		return d, st.warnings, p.diagnoseError(s, toks)
+	}
+
+	if p.ReckoningLocation != ReckoningLocationNone && d.Calendar() != Gregorian {
+		st.warn(WarningAssumedCalendar, "assumed %s calendar", d.Calendar())
+	}
+
+	return d, st.warnings, nil
+}
+
+// diagnoseError builds a ParseError for s by comparing its tokens with the common forms of date.
+func (p *Parser) diagnoseError(s string, toks []token) *ParseError {
+	perr := &ParseError{
+		Input:    s,
+		Expected: "a date",
+	}
+
+	// Classify each token, treating misspelt words as the word they most resemble
+	var classes []tokenClass
+	var positions []int
+	var misspelt []bool
+	for _, t := range toks {
+		c := classify(t)
+		if c == classSeparator {
+			continue
+		}
+		wrong := false
+		if c == classWord {
+			if w, ok := closestWord(t.text); ok {
+				c = classify(token{kind: tokWord, text: w})
+				wrong = true
+			}
+		}
+		classes = append(classes, c)
+		positions = append(positions, t.pos)
+		misspelt = append(misspelt, wrong)
+	}
+	if len(classes) == 0 {
+		perr.Pos = len(s)
+		return perr
+	}
+
+	best := -1
+	bestDist := 0
+	for i, f := range diagnosticForms {
+		if d := editDistance(classes, f.classes); best == -1 || d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	form := diagnosticForms[best]
+	perr.Nearest = form.name
+
+	// Report the first token that differs from the nearest form
+	perr.Pos = len(s)
+	perr.Expected = "end of input"
+	for i := range classes {
+		if i >= len(form.classes) {
+			perr.Pos = positions[i]
+			break
+		}
+		if classes[i] != form.classes[i] || misspelt[i] {
+			perr.Pos = positions[i]
+			perr.Expected = form.classes[i].String()
+			break
+		}
+	}
+	if len(classes) < len(form.classes) && perr.Pos == len(s) {
+		perr.Expected = form.classes[len(classes)].String()
+	}
+
+	// Try replacing unrecognised words with the closest known word
+	corrected := s
+	changed := false
+	for i := len(toks) - 1; i >= 0; i-- {
+		t := toks[i]
+		if classify(t) != classWord {
+			continue
+		}
+		if w, ok := closestWord(t.text); ok {
+			corrected = corrected[:t.pos] + w + corrected[t.pos+len(t.text):]
+			changed = true
+		}
+	}
+	if changed {
+		if d := p.parseInput(corrected, newParseState()); !IsUnknown(d) {
+			perr.Suggestion = corrected
+		}
+	}
+
+	return perr
+}
+
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == ',' || r == ';' || r == ':' || r == '(' || r == '['
+}
+
+// tokenClass is the role a token may play in a date, used for diagnostics
+type tokenClass int
+
+const (
+	classSeparator tokenClass = iota
+	classDay
+	classMonth
+	classYear
+	classNumber
+	classQualifier
+	classWeekday
+	classWord
+)
+
+func (c tokenClass) String() string {
+	switch c {
+	case classDay:
+		return "a day of the month"
+	case classMonth:
+		return "a month name"
+	case classYear:
+		return "a year"
+	case classNumber:
+		return "a number"
+	case classQualifier:
+		return "a qualifier such as bef., aft. or abt."
+	case classWeekday:
+		return "a day of the week"
+	case classWord:
+		return "a word"
+	default:
+		return "punctuation"
+	}
+}
+
+func classify(t token) tokenClass {
+	switch t.kind {
+	case tokNumber:
+		switch {
+		case len(t.text) >= 3 && len(t.text) <= 4:
+			return classYear
+		case len(t.text) <= 2:
+			if n, _ := strconv.Atoi(t.text); n >= 1 && n <= 31 {
+				return classDay
+			}
+		}
+		return classNumber
+	case tokWord:
+		w := strings.ToLower(t.text)
+		if _, ok := monthWords[w]; ok {
+			return classMonth
+		}
+		if qualifierWords[w] {
+			return classQualifier
+		}
+		if _, ok := weekdayNames[w]; ok {
+			return classWeekday
+		}
+		return classWord
+	}
+	return classSeparator
+}
+
+// diagnosticForms are the common forms of date that unparseable input is compared against
+var diagnosticForms = []struct {
+	name    string
+	classes []tokenClass
+}{
+	{name: "D MMM YYYY", classes: []tokenClass{classDay, classMonth, classYear}},
+	{name: "MMM D, YYYY", classes: []tokenClass{classMonth, classDay, classYear}},
+	{name: "MMM YYYY", classes: []tokenClass{classMonth, classYear}},
+	{name: "YYYY", classes: []tokenClass{classYear}},
+	{name: "YYYY-YYYY", classes: []tokenClass{classYear, classYear}},
+	{name: "bef. YYYY", classes: []tokenClass{classQualifier, classYear}},
+	{name: "Weekday D MMM YYYY", classes: []tokenClass{classWeekday, classDay, classMonth, classYear}},
+}
+
+var qualifierWords = map[string]bool{
+	"bef":       true,
+	"before":    true,
+	"aft":       true,
+	"after":     true,
+	"abt":       true,
+	"about":     true,
+	"est":       true,
+	"estimated": true,
+}
+
+// closestWord returns the known month name, qualifier or weekday closest to w, if one is
+// within a small edit distance.
+func closestWord(w string) (string, bool) {
+	lw := strings.ToLower(w)
+	if len(lw) < 3 {
+		return "", false
+	}
+	maxDist := 1
+	if len(lw) > 5 {
+		maxDist = 2
+	}
+
+	best := ""
+	bestDist := maxDist + 1
+	// Ties are broken in favour of the shortest and then the alphabetically first candidate, so that the
+	// result does not depend on the order in which the maps of words are visited
+	try := func(cand string) {
+		d := editDistance([]rune(lw), []rune(cand))
+		if d < bestDist || (d == bestDist && (len(cand) < len(best) || (len(cand) == len(best) && cand < best))) {
+			best, bestDist = cand, d
+		}
+	}
+	for i := 1; i <= 12; i++ {
+		try(strings.ToLower(longMonthNames[i]))
+		try(strings.ToLower(shortMonthNames[i]))
+	}
+	for q := range qualifierWords {
+		try(q)
+	}
+	for wd := range weekdayNames {
+		try(wd)
+	}
+	if best == "" {
+		return "", false
+	}
+	return matchCase(best, w), true
+}
+
+// matchCase returns w with the capitalisation of the first letter of model
+func matchCase(w, model string) string {
+	r, _ := utf8.DecodeRuneInString(model)
+	if unicode.IsUpper(r) {
+		return strings.ToUpper(w[:1]) + w[1:]
+	}
+	return w
+}
+
+// editDistance returns the optimal string alignment distance between a and b, which is the number of
+// insertions, deletions, substitutions and transpositions of adjacent elements needed to turn a into b.
+func editDistance[T comparable](a, b []T) int {
+	// rows i-2, i-1 and i of the distance matrix
+	rows := [3][]int{make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)}
+	for j := range rows[1] {
+		rows[1][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev2, prev, cur := rows[0], rows[1], rows[2]
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		rows[0], rows[1], rows[2] = prev, cur, prev2
+	}
+	return rows[1][len(b)]
+}
@@ -0,0 +1,165 @@
+package gdate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiagnose(t *testing.T) {
+	testCases := []struct {
+		s        string
+		p        Parser
+		want     Date
+		warnings []WarningKind
+		err      *ParseError
+	}{
+		{
+			s:    "5 Mar 1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:        "5 Mar 1850 (bur.)",
+			want:     &Precise{Y: 1850, M: 3, D: 5},
+			warnings: []WarningKind{WarningTrailingText},
+		},
+		{
+			s:        "abt. 1850, Leeds",
+			want:     &AboutYear{Y: 1850},
+			warnings: []WarningKind{WarningTrailingText},
+		},
+		{
+			s:        "2 Apr 1751",
+			p:        Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want:     &Precise{Y: 1751, M: 4, D: 2, C: Julian25Mar},
+			warnings: []WarningKind{WarningAssumedCalendar},
+		},
+		{
+			s:    "2 Apr 1752",
+			p:    Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want: &Precise{Y: 1752, M: 4, D: 2, C: Gregorian},
+		},
+		{
+			s:    "5 Marhc 1850",
+			want: &Unknown{Text: "5 Marhc 1850"},
+			err: &ParseError{
+				Input:      "5 Marhc 1850",
+				Pos:        2,
+				Expected:   "a month name",
+				Nearest:    "D MMM YYYY",
+				Suggestion: "5 March 1850",
+			},
+		},
+		{
+			s:    "befor 1850",
+			want: &Unknown{Text: "befor 1850"},
+			err: &ParseError{
+				Input:      "befor 1850",
+				Pos:        0,
+				Expected:   "a qualifier such as bef., aft. or abt.",
+				Nearest:    "bef. YYYY",
+				Suggestion: "before 1850",
+			},
+		},
+		{
+			s:    "5 Mar",
+			want: &Unknown{Text: "5 Mar"},
+			err: &ParseError{
+				Input:    "5 Mar",
+				Pos:      5,
+				Expected: "a year",
+				Nearest:  "D MMM YYYY",
+			},
+		},
+		{
+			s:    "",
+			want: &Unknown{},
+			err: &ParseError{
+				Input:    "",
+				Pos:      0,
+				Expected: "a date",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			dt, warnings, err := tc.p.Diagnose(tc.s)
+			if tc.err == nil {
+				if err != nil {
+					t.Fatalf("got unexpected error: %v", err)
+				}
+			} else {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("got error %v, wanted a *ParseError", err)
+				}
+				if diff := cmp.Diff(tc.err, perr); diff != "" {
+					t.Errorf("error mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want, dt); diff != "" {
+				t.Errorf("Diagnose(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+
+			var kinds []WarningKind
+			for _, w := range warnings {
+				kinds = append(kinds, w.Kind)
+			}
+			if diff := cmp.Diff(tc.warnings, kinds); diff != "" {
+				t.Errorf("warnings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnoseKeepsParseLenient(t *testing.T) {
+	dt, err := Parse("5 Marhc 1850")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if !IsUnknown(dt) {
+		t.Errorf("got %v, wanted unknown date", dt)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{a: "march", b: "march", want: 0},
+		{a: "marhc", b: "march", want: 1},
+		{a: "mrch", b: "march", want: 1},
+		{a: "marchh", b: "march", want: 1},
+		{a: "febuary", b: "february", want: 1},
+		{a: "", b: "may", want: 3},
+		{a: "june", b: "july", want: 2},
+	}
+	for _, tc := range testCases {
+		if got := editDistance([]rune(tc.a), []rune(tc.b)); got != tc.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestClosestWordTies(t *testing.T) {
+	testCases := []struct {
+		w    string
+		want string
+	}{
+		{w: "thue", want: "thu"},
+		{w: "sut", want: "sat"},
+		{w: "Marhc", want: "March"},
+	}
+	for _, tc := range testCases {
+		// Ties between candidates must not depend on the order in which maps are visited
+		for i := 0; i < 50; i++ {
+			if got, ok := closestWord(tc.w); !ok || got != tc.want {
+				t.Fatalf("closestWord(%q) = %q, %v, want %q", tc.w, got, ok, tc.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package gdate
+
+import (
+	"strings"
+	"time"
+)
+
+// Easter returns the date of Easter Sunday in year y of calendar c. Easter is calculated using the
+// Julian computus for the Julian and Julian25Mar calendars and the Gregorian computus for the
+// Gregorian calendar. For the Julian25Mar calendar y is the year as written, which begins on 25 Mar.
+func Easter(c Calendar, y int) *Precise {
+	d, _ := feastDay(feastRule{kind: feastEaster}, c, y)
+	return d
+}
+
+// FeastDay returns the date of the named feast in year y of calendar c and true, or false if the
+// name is not a known feast. Names are matched ignoring case, punctuation and words such as
+// "feast", "day" and "saint", so "the Feast of St Martin", "St Martin's Day" and "Martinmas" all
+// refer to the same feast. The name of a saint that is also a given name, such as Thomas, must be
+// marked as a feast by "St", "Saint", "SS", "Feast of" or "'s Day", and names that are also common
+// words, such as Lady, must be followed by "Day". Movable feasts are calculated from the date of Easter.
+func FeastDay(name string, c Calendar, y int) (*Precise, bool) {
+	rule, ok := feasts[feastKey(name)]
+	if !ok || !markedFeast(name, rule.marker) {
+		return nil, false
+	}
+	return feastDay(rule, c, y)
+}
+
+func feastDay(rule feastRule, c Calendar, y int) (*Precise, bool) {
+	switch rule.kind {
+	case feastFixed:
+		return &Precise{C: c, Y: y, M: rule.m, D: rule.d}, true
+	case feastWeekday:
+		jd := c.JulianDay(y, rule.m, rule.d)
+		jd += int(rule.wd-weekdayOf(jd)+7) % 7
+		return preciseFromJulianDay(c, jd), true
+	case feastEaster:
+		if c != Julian25Mar {
+			return preciseFromJulianDay(c, easterJulianDay(c, y)+rule.offset), true
+		}
+		// Movable feasts early in the year such as Shrove Tuesday fall before 25 Mar and are
+		// numbered with the previous year, so find the one that falls within year y.
+		for _, ey := range []int{y, y + 1} {
+			p := preciseFromJulianDay(c, easterJulianDay(c, ey)+rule.offset)
+			if p.Y == y {
+				return p, true
+			}
+		}
+		return preciseFromJulianDay(c, easterJulianDay(c, y)+rule.offset), true
+	}
+	return nil, false
+}
+
+// easterJulianDay returns the Julian day of Easter Sunday in year y, counting the year from 1 Jan.
+func easterJulianDay(c Calendar, y int) int {
+	if c == Gregorian {
+		a := y % 19
+		b := y / 100
+		cc := y % 100
+		d := b / 4
+		e := b % 4
+		f := (b + 8) / 25
+		g := (b - f + 1) / 3
+		h := (19*a + b - d - g + 15) % 30
+		i := cc / 4
+		k := cc % 4
+		l := (32 + 2*e + 2*i - h - k) % 7
+		m := (a + 11*h + 22*l) / 451
+		month := (h + l - 7*m + 114) / 31
+		day := (h+l-7*m+114)%31 + 1
+		return Gregorian.JulianDay(y, month, day)
+	}
+
+	a := y % 4
+	b := y % 7
+	cc := y % 19
+	d := (19*cc + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+	return Julian.JulianDay(y, month, day)
+}
+
+// weekdayOf returns the day of the week of the given Julian day.
+func weekdayOf(jd int) time.Weekday {
+	return time.Weekday((jd + 1) % 7)
+}
+
+func preciseFromJulianDay(c Calendar, jd int) *Precise {
+	y, m, d := c.FromJulianDay(jd)
+	return &Precise{C: c, Y: y, M: m, D: d}
+}
+
+type feastKind int
+
+const (
+	feastFixed   feastKind = 1 // a fixed day of the year
+	feastEaster  feastKind = 2 // a number of days from Easter Sunday
+	feastWeekday feastKind = 3 // the first weekday on or after a fixed day of the year
+)
+
+// feastMarker is the wording that a name must include to refer to a feast
+type feastMarker int
+
+const (
+	markSaint feastMarker = 1 // the name of a saint, written as "St Thomas", "the Feast of Thomas" or "Thomas's Day"
+	markDay   feastMarker = 2 // a name followed by "Day", as in "Lady Day", or one marked as the name of a saint
+)
+
+type feastRule struct {
+	kind   feastKind
+	m, d   int
+	offset int
+	wd     time.Weekday
+	marker feastMarker // the wording needed for names that are also common words or given names
+}
+
+func fixedFeast(m, d int) feastRule { return feastRule{kind: feastFixed, m: m, d: d} }
+func saintFeast(m, d int) feastRule {
+	return feastRule{kind: feastFixed, m: m, d: d, marker: markSaint}
+}
+func dayFeast(m, d int) feastRule       { return feastRule{kind: feastFixed, m: m, d: d, marker: markDay} }
+func movableFeast(offset int) feastRule { return feastRule{kind: feastEaster, offset: offset} }
+func weekdayFeast(wd time.Weekday, m, d int) feastRule {
+	return feastRule{kind: feastWeekday, wd: wd, m: m, d: d}
+}
+
+// feasts maps the normalised names of feasts to the rules used to find their dates.
+// Names are normalised by feastKey.
+var feasts = map[string]feastRule{
+	// Movable feasts
+	"septuagesima":     movableFeast(-63),
+	"sexagesima":       movableFeast(-56),
+	"quinquagesima":    movableFeast(-49),
+	"shrove sunday":    movableFeast(-49),
+	"shrove monday":    movableFeast(-48),
+	"shrove tuesday":   movableFeast(-47),
+	"pancake":          movableFeast(-47),
+	"ash wednesday":    movableFeast(-46),
+	"quadragesima":     movableFeast(-42),
+	"passion sunday":   movableFeast(-14),
+	"mothering sunday": movableFeast(-21),
+	"laetare sunday":   movableFeast(-21),
+	"palm sunday":      movableFeast(-7),
+	"maundy thursday":  movableFeast(-3),
+	"good friday":      movableFeast(-2),
+	"holy saturday":    movableFeast(-1),
+	"easter eve":       movableFeast(-1),
+	"easter":           movableFeast(0),
+	"easter sunday":    movableFeast(0),
+	"easter monday":    movableFeast(1),
+	"easter tuesday":   movableFeast(2),
+	"low sunday":       movableFeast(7),
+	"hock monday":      movableFeast(8),
+	"hock tuesday":     movableFeast(9),
+	"rogation sunday":  movableFeast(35),
+	"rogation monday":  movableFeast(36),
+	"ascension":        movableFeast(39),
+	"holy thursday":    movableFeast(39),
+	"whitsun":          movableFeast(49),
+	"whitsunday":       movableFeast(49),
+	"whit sunday":      movableFeast(49),
+	"pentecost":        movableFeast(49),
+	"whit monday":      movableFeast(50),
+	"whitsun monday":   movableFeast(50),
+	"whit tuesday":     movableFeast(51),
+	"whitsun tuesday":  movableFeast(51),
+	"trinity":          movableFeast(56),
+	"trinity sunday":   movableFeast(56),
+	"corpus christi":   movableFeast(60),
+	"advent sunday":    weekdayFeast(time.Sunday, 11, 27),
+	"plough monday":    weekdayFeast(time.Monday, 1, 7),
+
+	// Fixed feasts
+	"circumcision":           fixedFeast(1, 1),
+	"new year":               fixedFeast(1, 1),
+	"epiphany":               fixedFeast(1, 6),
+	"twelfth":                dayFeast(1, 6),
+	"hilary":                 saintFeast(1, 13),
+	"agnes":                  saintFeast(1, 21),
+	"vincent":                saintFeast(1, 22),
+	"conversion paul":        fixedFeast(1, 25),
+	"candlemas":              fixedFeast(2, 2),
+	"purification":           fixedFeast(2, 2),
+	"blaise":                 saintFeast(2, 3),
+	"valentine":              saintFeast(2, 14),
+	"matthias":               saintFeast(2, 24),
+	"david":                  saintFeast(3, 1),
+	"chad":                   saintFeast(3, 2),
+	"gregory":                saintFeast(3, 12),
+	"patrick":                saintFeast(3, 17),
+	"cuthbert":               saintFeast(3, 20),
+	"benedict":               saintFeast(3, 21),
+	"annunciation":           fixedFeast(3, 25),
+	"lady":                   dayFeast(3, 25),
+	"ladyday":                fixedFeast(3, 25),
+	"george":                 saintFeast(4, 23),
+	"mark":                   saintFeast(4, 25),
+	"philip james":           saintFeast(5, 1),
+	"may":                    dayFeast(5, 1),
+	"invention cross":        fixedFeast(5, 3),
+	"dunstan":                saintFeast(5, 19),
+	"augustine":              saintFeast(5, 26),
+	"barnabas":               saintFeast(6, 11),
+	"alban":                  saintFeast(6, 22),
+	"nativity john baptist":  fixedFeast(6, 24),
+	"john baptist":           saintFeast(6, 24),
+	"midsummer":              fixedFeast(6, 24),
+	"peter paul":             saintFeast(6, 29),
+	"peter":                  saintFeast(6, 29),
+	"translation thomas":     fixedFeast(7, 7),
+	"swithun":                saintFeast(7, 15),
+	"swithin":                saintFeast(7, 15),
+	"margaret":               saintFeast(7, 20),
+	"mary magdalene":         saintFeast(7, 22),
+	"james":                  saintFeast(7, 25),
+	"anne":                   saintFeast(7, 26),
+	"lammas":                 fixedFeast(8, 1),
+	"peter ad vincula":       fixedFeast(8, 1),
+	"transfiguration":        fixedFeast(8, 6),
+	"lawrence":               saintFeast(8, 10),
+	"laurence":               saintFeast(8, 10),
+	"assumption":             fixedFeast(8, 15),
+	"bartholomew":            saintFeast(8, 24),
+	"beheading john baptist": fixedFeast(8, 29),
+	"giles":                  saintFeast(9, 1),
+	"nativity mary":          fixedFeast(9, 8),
+	"nativity virgin mary":   fixedFeast(9, 8),
+	"holy cross":             fixedFeast(9, 14),
+	"holy rood":              fixedFeast(9, 14),
+	"exaltation cross":       fixedFeast(9, 14),
+	"matthew":                saintFeast(9, 21),
+	"michael":                saintFeast(9, 29),
+	"michael all angels":     fixedFeast(9, 29),
+	"michaelmas":             fixedFeast(9, 29),
+	"jerome":                 saintFeast(9, 30),
+	"faith":                  saintFeast(10, 6),
+	"denis":                  saintFeast(10, 9),
+	"edward confessor":       fixedFeast(10, 13),
+	"luke":                   saintFeast(10, 18),
+	"crispin":                saintFeast(10, 25),
+	"simon jude":             saintFeast(10, 28),
+	"all saints":             fixedFeast(11, 1),
+	"all hallows":            fixedFeast(11, 1),
+	"hallowmas":              fixedFeast(11, 1),
+	"all souls":              fixedFeast(11, 2),
+	"leonard":                saintFeast(11, 6),
+	"martin":                 saintFeast(11, 11),
+	"martinmas":              fixedFeast(11, 11),
+	"edmund":                 saintFeast(11, 20),
+	"clement":                saintFeast(11, 23),
+	"catherine":              saintFeast(11, 25),
+	"katherine":              saintFeast(11, 25),
+	"andrew":                 saintFeast(11, 30),
+	"nicholas":               saintFeast(12, 6),
+	"conception mary":        fixedFeast(12, 8),
+	"lucy":                   saintFeast(12, 13),
+	"thomas":                 saintFeast(12, 21),
+	"thomas apostle":         fixedFeast(12, 21),
+	"christmas":              fixedFeast(12, 25),
+	"xmas":                   fixedFeast(12, 25),
+	"nativity":               fixedFeast(12, 25),
+	"stephen":                saintFeast(12, 26),
+	"john evangelist":        fixedFeast(12, 27),
+	"holy innocents":         fixedFeast(12, 28),
+	"childermas":             fixedFeast(12, 28),
+	"thomas becket":          saintFeast(12, 29),
+	"sylvester":              saintFeast(12, 31),
+}
+
+// feastKeyStopWords are ignored when normalising the name of a feast
+var feastKeyStopWords = map[string]bool{
+	"the":     true,
+	"feast":   true,
+	"of":      true,
+	"day":     true,
+	"st":      true,
+	"saint":   true,
+	"ss":      true,
+	"sts":     true,
+	"and":     true,
+	"&":       true,
+	"our":     true,
+	"blessed": true,
+	"bvm":     true,
+}
+
+// markedFeast reports whether name includes the wording needed by marker to refer to a feast
+func markedFeast(name string, marker feastMarker) bool {
+	if marker == 0 {
+		return true
+	}
+	words := strings.Fields(strings.ToLower(markerReplacer.Replace(name)))
+	for i, w := range words {
+		switch {
+		case w == "st" || w == "saint" || w == "ss" || w == "sts":
+			return true
+		case w == "feast" && i+1 < len(words) && words[i+1] == "of":
+			return true
+		case w == "day" && (marker == markDay || (i > 0 && possessive(words[i-1]))):
+			return true
+		}
+	}
+	return false
+}
+
+// possessive reports whether w is a possessive such as "Thomas's" or "James'"
+func possessive(w string) bool {
+	for _, suffix := range []string{"'s", "’s", "s'", "s’"} {
+		if strings.HasSuffix(w, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// markerReplacer separates the words of the name of a feast
+var markerReplacer = strings.NewReplacer(".", " ", ",", " ")
+
+// feastKeyReplacer removes punctuation and possessives from the names of feasts
+var feastKeyReplacer = strings.NewReplacer(".", " ", ",", " ", "'s", "", "'", "", "’s", "", "’", "")
+
+// feastKey normalises the name of a feast for lookup in the feasts table
+func feastKey(name string) string {
+	name = strings.ToLower(name)
+	name = feastKeyReplacer.Replace(name)
+	words := strings.Fields(name)
+	kept := words[:0]
+	for _, w := range words {
+		if !feastKeyStopWords[w] {
+			kept = append(kept, w)
+		}
+	}
+	return strings.Join(kept, " ")
+}
@@ -0,0 +1,92 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEaster(t *testing.T) {
+	testCases := []struct {
+		c    Calendar
+		y    int
+		want *Precise
+	}{
+		{c: Gregorian, y: 1818, want: &Precise{Y: 1818, M: 3, D: 22}},
+		{c: Gregorian, y: 1850, want: &Precise{Y: 1850, M: 3, D: 31}},
+		{c: Gregorian, y: 1943, want: &Precise{Y: 1943, M: 4, D: 25}},
+		{c: Gregorian, y: 2000, want: &Precise{Y: 2000, M: 4, D: 23}},
+		{c: Gregorian, y: 2024, want: &Precise{Y: 2024, M: 3, D: 31}},
+		{c: Julian, y: 2008, want: &Precise{C: Julian, Y: 2008, M: 4, D: 14}},
+		{c: Julian, y: 2024, want: &Precise{C: Julian, Y: 2024, M: 4, D: 22}},
+		{c: Julian, y: 1612, want: &Precise{C: Julian, Y: 1612, M: 4, D: 12}},
+		{c: Julian25Mar, y: 1612, want: &Precise{C: Julian25Mar, Y: 1612, M: 4, D: 12}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want.String(), func(t *testing.T) {
+			got := Easter(tc.c, tc.y)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Easter(%s, %d) mismatch (-want +got):\n%s", tc.c, tc.y, diff)
+			}
+		})
+	}
+}
+
+func TestFeastDay(t *testing.T) {
+	testCases := []struct {
+		name string
+		c    Calendar
+		y    int
+		want *Precise
+	}{
+		{name: "Easter Monday", y: 1850, want: &Precise{Y: 1850, M: 4, D: 1}},
+		{name: "Good Friday", y: 1850, want: &Precise{Y: 1850, M: 3, D: 29}},
+		{name: "Whitsun", y: 1850, want: &Precise{Y: 1850, M: 5, D: 19}},
+		{name: "Whit Sunday", y: 1850, want: &Precise{Y: 1850, M: 5, D: 19}},
+		{name: "Ascension Day", y: 1850, want: &Precise{Y: 1850, M: 5, D: 9}},
+		{name: "the Feast of St Martin", y: 1450, want: &Precise{Y: 1450, M: 11, D: 11}},
+		{name: "St. Martin's Day", y: 1450, want: &Precise{Y: 1450, M: 11, D: 11}},
+		{name: "Martinmas", y: 1450, want: &Precise{Y: 1450, M: 11, D: 11}},
+		{name: "All Saints' Day", y: 1450, want: &Precise{Y: 1450, M: 11, D: 1}},
+		{name: "the Nativity of St John the Baptist", y: 1450, want: &Precise{Y: 1450, M: 6, D: 24}},
+		{name: "SS Peter and Paul", y: 1450, want: &Precise{Y: 1450, M: 6, D: 29}},
+		{name: "Advent Sunday", y: 1850, want: &Precise{Y: 1850, M: 12, D: 1}},
+		{name: "Plough Monday", y: 1850, want: &Precise{Y: 1850, M: 1, D: 7}},
+		{name: "St Thomas", y: 1851, want: &Precise{Y: 1851, M: 12, D: 21}},
+		{name: "Thomas's Day", y: 1851, want: &Precise{Y: 1851, M: 12, D: 21}},
+		{name: "the Feast of Anne", y: 1790, want: &Precise{Y: 1790, M: 7, D: 26}},
+		{name: "Lady Day", y: 1850, want: &Precise{Y: 1850, M: 3, D: 25}},
+		{name: "Candlemas", c: Julian25Mar, y: 1700, want: &Precise{C: Julian25Mar, Y: 1700, M: 2, D: 2}},
+		{
+			// Shrove Tuesday of OS 1612 falls in Feb of the following Julian year
+			name: "Shrove Tuesday",
+			c:    Julian25Mar,
+			y:    1612,
+			want: &Precise{C: Julian25Mar, Y: 1612, M: 2, D: 16},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := FeastDay(tc.name, tc.c, tc.y)
+			if !ok {
+				t.Fatalf("FeastDay(%q) not found", tc.name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FeastDay(%q, %s, %d) mismatch (-want +got):\n%s", tc.name, tc.c, tc.y, diff)
+			}
+		})
+	}
+
+	if _, ok := FeastDay("Not a feast", Gregorian, 1850); ok {
+		t.Errorf("FeastDay found unknown feast")
+	}
+
+	// Given names and common words are only feasts when marked as one
+	for _, name := range []string{"Thomas", "Anne", "George", "Peter Paul", "Margaret", "Lady", "Thomas Day"} {
+		if d, ok := FeastDay(name, Gregorian, 1850); ok {
+			t.Errorf("FeastDay(%q) got %s, want not found", name, d)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package gdate
+
+// A Match is a date found in a piece of text
+type Match struct {
+	Start int    // byte offset of the start of the date in the text
+	End   int    // byte offset of the end of the date in the text
+	Text  string // the text of the date, equal to text[Start:End]
+	Date  Date
+}
+
+// maxMatchTokens is the maximum number of tokens that FindAll will consider as a single date
+const maxMatchTokens = 16
+
+// FindAll finds every date in text using the default parser and returns them in the order they appear.
+func FindAll(text string) []Match {
+	return defaultParser.FindAll(text)
+}
+
+// FindAll scans text for dates that the parser can recognise and returns them in the order they
+// appear. Where dates overlap, the longest one starting earliest in the text is returned, so
+// "about 1851" is found in preference to "1851". A number on its own that could be a year is reported
+// as a date, as in "born 1850", unless it follows a word such as "lot" or "no." that introduces a
+// reference number or is followed by a unit such as "acres".
+func (p *Parser) FindAll(text string) []Match {
+	var matches []Match
+	toks := tokenize(text)
+
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind == tokPunct && toks[i].text != "'" && !startsUnknownRun(toks[i:]) {
+			continue
+		}
+
+		last := min(i+maxMatchTokens, len(toks)) - 1
+		for j := last; j >= i; j-- {
+			if toks[j].kind == tokPunct && !isUnknownMark(toks[j]) {
+				continue
+			}
+			d := p.parseTokens(text, toks[i:j+1], newParseState())
+			if d == nil {
+				continue
+			}
+			if _, ok := d.(*Year); ok && j == i && quantity(toks, i) {
+				continue
+			}
+			start, end := toks[i].pos, toks[j].end()
+			matches = append(matches, Match{
+				Start: start,
+				End:   end,
+				Text:  text[start:end],
+				Date:  d,
+			})
+			i = j
+			break
+		}
+	}
+
+	return matches
+}
+
+// startsUnknownRun reports whether toks begins with at least two adjacent question marks or underscores,
+// such as the "??" of "?? Mar 1850". A single question mark is more likely to end a sentence.
+func startsUnknownRun(toks []token) bool {
+	return len(toks) > 1 && isUnknownMark(toks[0]) && isUnknownMark(toks[1]) && toks[1].pos == toks[0].end()
+}
+
+// referenceWords introduce a number that is not a year, as in "lot 1850" or "No. 1850"
+var referenceWords = []string{"lot", "lots", "plot", "no", "nos", "number", "#", "page", "folio", "fo", "piece", "item"}
+
+// unitWords follow a number that is not a year, as in "1200 acres"
+var unitWords = []string{
+	"acre", "acres", "rood", "roods", "perch", "perches", "pole", "poles", "yard", "yards", "yds", "foot", "feet",
+	"ft", "mile", "miles", "pound", "pounds", "lb", "lbs", "ton", "tons", "bushel", "bushels", "head", "sheep",
+}
+
+// quantity reports whether the number toks[i] is a quantity or reference number rather than a year, as it is
+// when it follows a word in referenceWords, such as "No." or "lot", or comes before a word in unitWords.
+func quantity(toks []token, i int) bool {
+	k := i - 1
+	if k > 0 && toks[k].is(".") {
+		k--
+	}
+	if k >= 0 && isAny(toks[k], referenceWords) {
+		return true
+	}
+	return i+1 < len(toks) && isAny(toks[i+1], unitWords)
+}
+
+// isAny reports whether t is any of the words ws
+func isAny(t token, ws []string) bool {
+	for _, w := range ws {
+		if t.is(w) {
+			return true
+		}
+	}
+	return false
+}
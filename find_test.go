@@ -0,0 +1,130 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindAll(t *testing.T) {
+	testCases := []struct {
+		text string
+		p    Parser
+		want []Match
+	}{
+		{
+			text: "baptised 5 March 1850, buried about 1851 at St Mary's",
+			want: []Match{
+				{Start: 9, End: 21, Text: "5 March 1850", Date: &Precise{Y: 1850, M: 3, D: 5}},
+				{Start: 30, End: 40, Text: "about 1851", Date: &AboutYear{Y: 1851}},
+			},
+		},
+		{
+			text: "Married on Tuesday 5 March 1850 at the parish church.",
+			want: []Match{
+				{Start: 11, End: 31, Text: "Tuesday 5 March 1850", Date: &Precise{Y: 1850, M: 3, D: 5}},
+			},
+		},
+		{
+			text: "Resident in the 1850s; the lease was renewed at Michaelmas 1861.",
+			want: []Match{
+				{Start: 16, End: 21, Text: "1850s", Date: &Decade{Y: 1850}},
+				{Start: 48, End: 63, Text: "Michaelmas 1861", Date: &QuarterDay{Y: 1861, Q: 3}},
+			},
+		},
+		{
+			text: "born  5 Mar\n1850",
+			want: []Match{
+				{Start: 6, End: 16, Text: "5 Mar\n1850", Date: &Precise{Y: 1850, M: 3, D: 5}},
+			},
+		},
+		{
+			text: "recorded Mar '52 in the register",
+			p:    Parser{TwoDigitYearPivot: 1899},
+			want: []Match{
+				{Start: 9, End: 16, Text: "Mar '52", Date: &MonthYear{Y: 1852, M: 3}},
+			},
+		},
+		{
+			text: "Who was born ?? Mar 1850? Probably his brother, born in 185?",
+			want: []Match{
+				{Start: 13, End: 24, Text: "?? Mar 1850", Date: &Partial{Y: 1850, M: 3}},
+				{Start: 56, End: 60, Text: "185?", Date: &Decade{Y: 1850}},
+			},
+		},
+		{
+			text: "baptised 5 or 6 March 1850 at St Mary's",
+			want: []Match{
+				{Start: 9, End: 26, Text: "5 or 6 March 1850", Date: &OneOf{Dates: []Date{&Precise{Y: 1850, M: 3, D: 5}, &Precise{Y: 1850, M: 3, D: 6}}}},
+			},
+		},
+		{
+			text: "no dates here",
+			want: nil,
+		},
+		{
+			text: "He farmed 1200 acres",
+			want: nil,
+		},
+		{
+			text: "sold as lot 1850 at the auction",
+			want: nil,
+		},
+		{
+			text: "witnessed by Thomas 1851 and George 1852",
+			want: []Match{
+				{Start: 20, End: 24, Text: "1851", Date: &Year{Y: 1851}},
+				{Start: 36, End: 40, Text: "1852", Date: &Year{Y: 1852}},
+			},
+		},
+		{
+			text: "No. 1850 High Street",
+			want: nil,
+		},
+		{
+			text: "born 1850, died 1901",
+			want: []Match{
+				{Start: 5, End: 9, Text: "1850", Date: &Year{Y: 1850}},
+				{Start: 16, End: 20, Text: "1901", Date: &Year{Y: 1901}},
+			},
+		},
+		{
+			text: "buried 1851",
+			want: []Match{
+				{Start: 7, End: 11, Text: "1851", Date: &Year{Y: 1851}},
+			},
+		},
+		{
+			text: "aged 45 in the 1851 census",
+			want: []Match{
+				{Start: 15, End: 19, Text: "1851", Date: &Year{Y: 1851}},
+			},
+		},
+		{
+			text: "sold as lot 1850 in 1851",
+			want: []Match{
+				{Start: 20, End: 24, Text: "1851", Date: &Year{Y: 1851}},
+			},
+		},
+		{
+			text: "lived there from 1850 until 1861",
+			want: []Match{
+				{Start: 12, End: 32, Text: "from 1850 until 1861", Date: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1861}}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			got := tc.p.FindAll(tc.text)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindAll mismatch (-want +got):\n%s", diff)
+			}
+			for _, m := range got {
+				if tc.text[m.Start:m.End] != m.Text {
+					t.Errorf("match text %q does not match offsets %d-%d", m.Text, m.Start, m.End)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package gdate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ocrDigits maps letters that optical character recognition commonly mistakes for digits
+var ocrDigits = map[byte]byte{
+	'l': '1',
+	'I': '1',
+	'i': '1',
+	'O': '0',
+	'o': '0',
+	'S': '5',
+	'B': '8',
+	'Z': '2',
+}
+
+// numberSuffixes may follow a number, as in "1850s" or "5th", and are not mistaken digits. The empty
+// suffix is tried last.
+var numberSuffixes = []string{"st", "nd", "rd", "th", "s", ""}
+
+// knownWords are the words recognised by the parser, which are never corrected in fuzzy mode
+var knownWords = map[string]bool{}
+
+func init() {
+	for _, w := range []string{
+		"the", "of", "day", "days", "next", "morrow", "eve", "vigil", "octave",
+		"early", "mid", "late", "half", "century", "cent", "q", "s", "st", "nd", "rd", "th", "lady",
+		"and", "or", "et", "in", "from", "to", "until", "till", "qtr", "quarter", "year", "our", "lord", "anno", "domini", "die",
+	} {
+		knownWords[w] = true
+	}
+	for w := range monthWords {
+		knownWords[w] = true
+	}
+	for w := range weekdayNames {
+		knownWords[w] = true
+	}
+	for w := range qualifierWords {
+		knownWords[w] = true
+	}
+	for w := range seasonWords {
+		knownWords[w] = true
+	}
+	for w := range quarterDayNames {
+		knownWords[w] = true
+	}
+	for w := range numberWords {
+		knownWords[w] = true
+	}
+	for w := range feastKeyStopWords {
+		knownWords[w] = true
+	}
+	for name := range feasts {
+		for _, w := range strings.Fields(name) {
+			knownWords[w] = true
+		}
+	}
+}
+
+// parseFuzzy corrects common OCR and transcription errors in s and parses the corrected text,
+// recording each correction as a warning. Letters mistaken for digits and words that are split
+// or misspelt are corrected first, then punctuation is ignored if the date still cannot be parsed.
+// It returns nil if the corrected text does not form a date.
+func (p *Parser) parseFuzzy(s string, st *parseState) Date {
+	var fixes []string
+	s = fixDigits(s, &fixes)
+	s = fixWords(s, &fixes)
+	if d := p.parseCorrected(s, fixes, st); d != nil {
+		return d
+	}
+
+	s, ignored := stripPunct(s)
+	if ignored == "" {
+		return nil
+	}
+	fixes = append(fixes, fmt.Sprintf("ignored punctuation %q", ignored))
+	return p.parseCorrected(s, fixes, st)
+}
+
+// parseCorrected parses s, which has been corrected as described by fixes, recording the
+// corrections as warnings if s forms a date.
+func (p *Parser) parseCorrected(s string, fixes []string, st *parseState) Date {
+	fst := newParseState()
+	var buf [16]token
+	d := p.parseTokens(s, appendTokens(buf[:0], s), fst)
+	if d == nil {
+		return nil
+	}
+	for _, f := range fixes {
+		st.warn(WarningCorrected, "%s", f)
+		st.confidence *= 0.9
+	}
+	st.merge(fst)
+	return d
+}
+
+// fixDigits replaces letters mistaken for digits in runs of letters and digits that are mostly digits,
+// such as "l850" or "185O". A suffix such as the "s" of "185Os" is kept.
+func fixDigits(s string, fixes *[]string) string {
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(s); {
+		if !isLetterASCII(s[i]) && !isDigit(rune(s[i])) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(s) && (isLetterASCII(s[j]) || isDigit(rune(s[j]))) {
+			j++
+		}
+		if fixed, ok := fixDigitRun(s[i:j]); ok {
+			b.WriteString(s[last:i])
+			b.WriteString(fixed)
+			last = j
+			*fixes = append(*fixes, fmt.Sprintf("corrected %q to %q", s[i:j], fixed))
+		}
+		i = j
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// fixDigitRun returns run with mistaken letters replaced by digits, or false if run is not a number.
+func fixDigitRun(run string) (string, bool) {
+	for _, suffix := range numberSuffixes {
+		if len(suffix) >= len(run) || !strings.HasSuffix(strings.ToLower(run), suffix) {
+			continue
+		}
+		body := run[:len(run)-len(suffix)]
+		digits, mistaken, others := 0, 0, 0
+		for k := 0; k < len(body); k++ {
+			switch {
+			case isDigit(rune(body[k])):
+				digits++
+			case ocrDigits[body[k]] != 0:
+				mistaken++
+			default:
+				others++
+			}
+		}
+		if others > 0 || mistaken == 0 || digits < mistaken {
+			continue
+		}
+		fixed := []byte(body)
+		for k, c := range fixed {
+			if d := ocrDigits[c]; d != 0 {
+				fixed[k] = d
+			}
+		}
+		return string(fixed) + run[len(body):], true
+	}
+	return "", false
+}
+
+// fixWords joins words split by a stray space, such as "Mar ch", and replaces misspelt month names,
+// qualifiers and weekdays with the closest known word.
+func fixWords(s string, fixes *[]string) string {
+	toks := tokenize(s)
+	var b strings.Builder
+	prevEnd := -1
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		text := t.text
+		if t.kind == tokWord {
+			if i+1 < len(toks) && toks[i+1].kind == tokWord && !knownWords[strings.ToLower(toks[i+1].text)] {
+				// A word split by a space, such as "Mar ch" or "Sept ember"
+				joined := text + toks[i+1].text
+				w, ok := joined, false
+				if _, ok = lookup(monthWords, joined); !ok {
+					if w, ok = closestWord(joined); ok {
+						_, ok = lookup(monthWords, w)
+					}
+				}
+				if ok {
+					*fixes = append(*fixes, fmt.Sprintf("corrected %q to %q", s[t.pos:toks[i+1].end()], w))
+					text = w
+					i++
+				}
+			}
+			if text == t.text && !knownWords[strings.ToLower(text)] {
+				if w, ok := closestWord(text); ok {
+					*fixes = append(*fixes, fmt.Sprintf("corrected %q to %q", text, w))
+					text = w
+				}
+			}
+		}
+		if prevEnd >= 0 && t.pos > prevEnd {
+			b.WriteByte(' ')
+		}
+		b.WriteString(text)
+		prevEnd = toks[i].end()
+	}
+	return b.String()
+}
+
+// stripPunct removes punctuation other than the hyphens, slashes and apostrophes used within dates
+// and returns the punctuation that was removed.
+func stripPunct(s string) (string, string) {
+	var b, ignored strings.Builder
+	prevEnd := -1
+	for _, t := range tokenize(s) {
+		if t.kind == tokPunct && t.text != "-" && t.text != "/" && t.text != "'" {
+			ignored.WriteString(t.text)
+			continue
+		}
+		if prevEnd >= 0 && t.pos > prevEnd {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.text)
+		prevEnd = t.end()
+	}
+	return b.String(), ignored.String()
+}
@@ -0,0 +1,122 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFuzzy(t *testing.T) {
+	testCases := []struct {
+		s           string
+		want        Date
+		corrections []string
+	}{
+		{
+			s:           "l850",
+			want:        &Year{Y: 1850},
+			corrections: []string{`corrected "l850" to "1850"`},
+		},
+		{
+			s:           "5 Mar. 185O",
+			want:        &Precise{Y: 1850, M: 3, D: 5},
+			corrections: []string{`corrected "185O" to "1850"`},
+		},
+		{
+			s:           "Mar ch 1850",
+			want:        &MonthYear{Y: 1850, M: 3},
+			corrections: []string{`corrected "Mar ch" to "March"`},
+		},
+		{
+			s:           "5th. March, 1850.",
+			want:        &Precise{Y: 1850, M: 3, D: 5},
+			corrections: []string{`ignored punctuation ".,."`},
+		},
+		{
+			s:           "Febuary 1850",
+			want:        &MonthYear{Y: 1850, M: 2},
+			corrections: []string{`corrected "Febuary" to "February"`},
+		},
+		{
+			s:           "bef  l85O",
+			want:        &BeforeYear{Y: 1850},
+			corrections: []string{`corrected "l85O" to "1850"`},
+		},
+		{
+			s:           "early l850s",
+			want:        &Decade{Y: 1850, Part: PartEarly},
+			corrections: []string{`corrected "l850s" to "1850s"`},
+		},
+		{
+			s:           "Tusday 5 Mar 1850",
+			want:        &Precise{Y: 1850, M: 3, D: 5},
+			corrections: []string{`corrected "Tusday" to "Tuesday"`},
+		},
+		{
+			s:    "5  March  1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "the Feast of St Nobody 1450",
+			want: &Unknown{Text: "the Feast of St Nobody 1450"},
+		},
+		{
+			s:    "Oct 1850",
+			want: &MonthYear{Y: 1850, M: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			p := &Parser{Fuzzy: true}
+			dt, warnings, err := p.ParseWithWarnings(tc.s)
+			if err != nil {
+				t.Fatalf("got unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, dt); diff != "" {
+				t.Errorf("Parse(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+
+			var corrections []string
+			for _, w := range warnings {
+				if w.Kind == WarningCorrected {
+					corrections = append(corrections, w.Message)
+				}
+			}
+			if diff := cmp.Diff(tc.corrections, corrections); diff != "" {
+				t.Errorf("corrections mismatch (-want +got):\n%s", diff)
+			}
+
+			if len(tc.corrections) > 0 {
+				// Corrections are only made when fuzzy parsing is enabled
+				if dt, _ := Parse(tc.s); !IsUnknown(dt) {
+					t.Errorf("Parse(%q) without fuzzy parsing got %v, wanted unknown date", tc.s, dt)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFuzzyStable(t *testing.T) {
+	p := &Parser{Fuzzy: true}
+	want, wantWarnings, err := p.ParseWithWarnings("Thue 5 Mar 1850")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]Warning{
+		{Kind: WarningCorrected, Message: `corrected "Thue" to "Thu"`},
+		{Kind: WarningWeekdayMismatch, Message: "5 Mar 1850 was a Tuesday, not a Thursday"},
+	}, wantWarnings); diff != "" {
+		t.Fatalf("warnings mismatch (-want +got):\n%s", diff)
+	}
+	for i := 0; i < 50; i++ {
+		dt, warnings, _ := p.ParseWithWarnings("Thue 5 Mar 1850")
+		if diff := cmp.Diff(want, dt); diff != "" {
+			t.Fatalf("run %d: date mismatch (-want +got):\n%s", i, diff)
+		}
+		if diff := cmp.Diff(wantWarnings, warnings); diff != "" {
+			t.Fatalf("run %d: warnings mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
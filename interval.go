@@ -1,15 +1,19 @@
 package gdate
 
-import (
-	"fmt"
-	"time"
-)
+import "fmt"
 
 type Interval interface {
 	Precise() string
 	Rough() string
 }
 
+// IntervalBetween returns the interval from a to b. When both dates are precise the interval is a
+// PreciseInterval counted in the calendar of a, converting b to that calendar via its Julian day if the
+// dates are in different calendars. Months are counted in the Julian25Mar calendar as in the Julian
+// calendar, ignoring the start of the Old Style year. When both dates have a year the interval is a
+// YearsInterval. The interval is negative when b is before a, with each of its parts negated, and
+// adding the interval to a with Add gives b. An UnknownInterval is returned if either date is unknown
+// or the interval cannot be determined.
 func IntervalBetween(a, b Date) Interval {
 	if IsUnknown(a) || IsUnknown(b) {
 		return &UnknownInterval{}
@@ -17,33 +21,30 @@ func IntervalBetween(a, b Date) Interval {
 	ap, aok := AsPrecise(a)
 	bp, bok := AsPrecise(b)
 	if aok && bok {
-		in := &PreciseInterval{
-			Y: bp.Y - ap.Y,
-			M: bp.M - ap.M,
-			D: bp.D - ap.D,
-		}
-
-		if in.D < 0 {
-			t := time.Date(ap.Y, time.Month(ap.M), 32, 0, 0, 0, 0, time.UTC)
-			in.D += 32 - t.Day()
-			in.M--
+		// Count the whole months from a towards b and then the remaining days, moving the day of a back to
+		// the end of a shorter month as Add does, so that adding the interval to a gives b.
+		c := ap.C
+		ja, jb := ap.EarliestJulianDay(), bp.EarliestJulianDay()
+		y1, m1, _ := reckoning(c).FromJulianDay(ja)
+		y2, m2, _ := reckoning(c).FromJulianDay(jb)
+		months := (y2-y1)*12 + m2 - m1
+		switch {
+		case jb >= ja && shiftDay(c, ja, 0, months, 0) > jb:
+			months--
+		case jb < ja && shiftDay(c, ja, 0, months, 0) < jb:
+			months++
 		}
-		if in.M < 0 {
-			in.M += 12
-			in.Y--
+		return &PreciseInterval{
+			Y: months / 12,
+			M: months % 12,
+			D: jb - shiftDay(c, ja, 0, months, 0),
 		}
-
-		return in
 	}
 
 	ay, aok := AsYear(a)
 	by, bok := AsYear(b)
 	if aok && bok {
-		diff := by.Y - ay.Y
-		if diff < 0 {
-			diff = -diff
-		}
-		return &YearsInterval{Y: diff}
+		return &YearsInterval{Y: by.Y - ay.Y}
 	}
 
 	return &UnknownInterval{}
@@ -92,6 +93,9 @@ type PreciseInterval struct {
 var _ Interval = (*PreciseInterval)(nil)
 
 func (p *PreciseInterval) Precise() string {
+	if p.negative() {
+		return "-" + p.negate().Precise()
+	}
 	var str string
 	if p.Y > 0 {
 		str += pluralise(p.Y, "year")
@@ -133,6 +137,9 @@ func (p *PreciseInterval) YMD() (int, int, int) {
 }
 
 func (p *PreciseInterval) Rough() string {
+	if p.negative() {
+		return "-" + p.negate().Rough()
+	}
 	if p.Y > 0 {
 		if p.M > 10 {
 			return "nearly " + pluralise(p.Y+1, "year")
@@ -148,9 +155,19 @@ func (p *PreciseInterval) Rough() string {
 	return pluralise(p.D, "day")
 }
 
+// negative reports whether the interval is negative, as it is when it runs back in time
+func (p *PreciseInterval) negative() bool {
+	return p.Y < 0 || p.M < 0 || p.D < 0
+}
+
+// negate returns the interval with each of its parts negated
+func (p *PreciseInterval) negate() *PreciseInterval {
+	return &PreciseInterval{Y: -p.Y, M: -p.M, D: -p.D}
+}
+
 func pluralise(n int, stem string) string {
 	var suffix string
-	if n != 1 {
+	if n != 1 && n != -1 {
 		suffix = "s"
 	}
 	return fmt.Sprintf("%d %s%s", n, stem, suffix)
@@ -205,3 +222,67 @@ func (i *AboutYearsInterval) Rough() string {
 func (p *AboutYearsInterval) Years() int {
 	return p.Y
 }
+
+// LessThanInterval represents an interval that is known only to be shorter than another interval, such as
+// the GEDCOM age "< 1y".
+type LessThanInterval struct {
+	In Interval
+}
+
+var _ Interval = (*LessThanInterval)(nil)
+
+func (i *LessThanInterval) Precise() string {
+	return "under " + i.In.Precise()
+}
+
+func (i *LessThanInterval) Rough() string {
+	return "under " + i.In.Rough()
+}
+
+// GreaterThanInterval represents an interval that is known only to be at least as long as another interval,
+// such as the GEDCOM age "> 45y".
+type GreaterThanInterval struct {
+	In Interval
+}
+
+var _ Interval = (*GreaterThanInterval)(nil)
+
+func (i *GreaterThanInterval) Precise() string {
+	return "over " + i.In.Precise()
+}
+
+func (i *GreaterThanInterval) Rough() string {
+	return "over " + i.In.Rough()
+}
+
+// AgeKeyword is an age described by a word rather than a number.
+type AgeKeyword int
+
+const (
+	AgeChild     AgeKeyword = 1 // a child, under 8 years old
+	AgeInfant    AgeKeyword = 2 // an infant, under 1 year old
+	AgeStillborn AgeKeyword = 3 // stillborn, or died at or near birth
+)
+
+// KeywordInterval represents an age described by a word, such as the GEDCOM ages CHILD, INFANT and STILLBORN.
+type KeywordInterval struct {
+	K AgeKeyword
+}
+
+var _ Interval = (*KeywordInterval)(nil)
+
+func (i *KeywordInterval) Precise() string {
+	switch i.K {
+	case AgeChild:
+		return "child"
+	case AgeInfant:
+		return "infant"
+	case AgeStillborn:
+		return "stillborn"
+	}
+	return "unknown"
+}
+
+func (i *KeywordInterval) Rough() string {
+	return i.Precise()
+}
@@ -80,7 +80,43 @@ func TestIntervalBetween(t *testing.T) {
 		{
 			a:    &Year{Y: 1846},
 			b:    &Year{Y: 1845},
-			want: &YearsInterval{Y: 1},
+			want: &YearsInterval{Y: -1},
+		},
+
+		// Calendars
+		{
+			// leap year in the Julian calendar but not the Gregorian
+			a:    &Precise{C: Julian, Y: 1700, M: 2, D: 27},
+			b:    &Precise{C: Julian, Y: 1700, M: 3, D: 1},
+			want: &PreciseInterval{D: 3},
+		},
+		{
+			// the Old Style year 1699 ends on 24 Mar
+			a:    &Precise{C: Julian25Mar, Y: 1699, M: 3, D: 20},
+			b:    &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 30},
+			want: &PreciseInterval{D: 10},
+		},
+		{
+			a:    &Precise{C: Julian25Mar, Y: 1699, M: 12, D: 20},
+			b:    &Precise{C: Julian25Mar, Y: 1699, M: 1, D: 20},
+			want: &PreciseInterval{M: 1},
+		},
+		{
+			a:    &Precise{C: Julian, Y: 1752, M: 9, D: 2},
+			b:    &Precise{C: Gregorian, Y: 1752, M: 9, D: 14},
+			want: &PreciseInterval{D: 1},
+		},
+
+		// Negative intervals
+		{
+			a:    &Precise{Y: 1845, M: 6, D: 16},
+			b:    &Precise{Y: 1845, M: 6, D: 15},
+			want: &PreciseInterval{D: -1},
+		},
+		{
+			a:    &Precise{Y: 1846, M: 7, D: 16},
+			b:    &Precise{Y: 1845, M: 6, D: 15},
+			want: &PreciseInterval{Y: -1, M: -1, D: -1},
 		},
 	}
 
@@ -94,3 +130,46 @@ func TestIntervalBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestPreciseIntervalNegative(t *testing.T) {
+	in := &PreciseInterval{Y: -1, M: -1, D: -1}
+	if got, want := in.Precise(), "-1 year, 1 month and 1 day"; got != want {
+		t.Errorf("got Precise()=%q, want %q", got, want)
+	}
+	if got, want := (&YearsInterval{Y: -1}).Precise(), "-1 year"; got != want {
+		t.Errorf("got Precise()=%q, want %q", got, want)
+	}
+}
+
+func TestIntervalBetweenRoundTrip(t *testing.T) {
+	testCases := []struct {
+		a, b *Precise
+		want *PreciseInterval
+	}{
+		{a: &Precise{Y: 1850, M: 1, D: 25}, b: &Precise{Y: 1850, M: 3, D: 24}, want: &PreciseInterval{M: 1, D: 27}},
+		{a: &Precise{Y: 1852, M: 1, D: 25}, b: &Precise{Y: 1852, M: 3, D: 24}, want: &PreciseInterval{M: 1, D: 28}},
+		{a: &Precise{Y: 1850, M: 1, D: 31}, b: &Precise{Y: 1850, M: 3, D: 1}, want: &PreciseInterval{M: 1, D: 1}},
+		{a: &Precise{Y: 1850, M: 1, D: 31}, b: &Precise{Y: 1850, M: 2, D: 28}, want: &PreciseInterval{M: 1}},
+		{a: &Precise{Y: 1850, M: 3, D: 31}, b: &Precise{Y: 1850, M: 4, D: 30}, want: &PreciseInterval{M: 1}},
+		{a: &Precise{Y: 1850, M: 12, D: 31}, b: &Precise{Y: 1851, M: 3, D: 30}, want: &PreciseInterval{M: 2, D: 30}},
+		{a: &Precise{Y: 1850, M: 3, D: 24}, b: &Precise{Y: 1850, M: 1, D: 25}, want: &PreciseInterval{M: -1, D: -30}},
+		{a: &Precise{Y: 1850, M: 3, D: 31}, b: &Precise{Y: 1850, M: 2, D: 28}, want: &PreciseInterval{M: -1}},
+		{a: &Precise{Y: 1850, M: 3, D: 1}, b: &Precise{Y: 1850, M: 1, D: 31}, want: &PreciseInterval{M: -1, D: -1}},
+		{a: &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 25}, b: &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 24}, want: &PreciseInterval{M: 11, D: 27}},
+		{a: &Precise{C: Julian25Mar, Y: 1699, M: 3, D: 24}, b: &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 25}, want: &PreciseInterval{D: 1}},
+		{a: &Precise{C: Julian25Mar, Y: 1699, M: 12, D: 31}, b: &Precise{C: Julian25Mar, Y: 1699, M: 2, D: 29}, want: &PreciseInterval{M: 2}},
+		{a: &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 24}, b: &Precise{C: Julian25Mar, Y: 1700, M: 3, D: 25}, want: &PreciseInterval{M: -11, D: -30}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			in := IntervalBetween(tc.a, tc.b)
+			if diff := cmp.Diff(Interval(tc.want), in); diff != "" {
+				t.Errorf("IntervalBetween mismatch (-want +got):\n%s", diff)
+			}
+			if got := Add(tc.a, in); !Equal(got, tc.b) {
+				t.Errorf("got Add(%s, %s)=%s, want %s", tc.a, in.Precise(), got, tc.b)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package gdate
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Equal reports whether a and b are the same date, which is when they have the same canonical form as
+// returned by Normalize. For example the Year 1850 is equal to the YearRange 1850-1850, and the
+// YearQuarter Jan-Mar 1850 is equal to the MonthYearRange Jan 1850-Mar 1850. Dates written in different
+// calendars are never equal, even when they fall on the same days.
+func Equal(a, b Date) bool {
+	return reflect.DeepEqual(Normalize(a), Normalize(b))
+}
+
+// Normalize returns the canonical form of d, which is the most specific type of date that has the same
+// bounds and qualifiers as d. A date with bounds becomes a Precise date if it falls on a single day,
+// otherwise a MonthYear, YearQuarter, Year, Decade or Century if its bounds are exactly one of those,
+// otherwise a MonthYearRange or YearRange if it spans whole months, and a BetweenPrecise if it does not.
+// A date before or after a day becomes a BeforeYear, BeforeMonthYear or BeforePrecise, or the equivalent
+// after type, naming the day as coarsely as possible. The dates in a OneOf or AllOf are normalized, ordered
+// and any duplicates removed, and a set of one date becomes that date. The start and end of a Period are
+// normalized. Approximate, partial and unknown dates that cannot be expressed as another type are
+// returned unchanged.
+func Normalize(d Date) Date {
+	switch td := d.(type) {
+	case nil:
+		return nil
+	case *OneOf:
+		dates := normalizeSet(td.Dates)
+		if len(dates) == 1 {
+			return dates[0]
+		}
+		return &OneOf{Dates: dates}
+	case *AllOf:
+		dates := normalizeSet(td.Dates)
+		if len(dates) == 1 {
+			return dates[0]
+		}
+		return &AllOf{Dates: dates}
+	case *Period:
+		return &Period{Start: Normalize(td.Start), End: Normalize(td.End)}
+	case *Partial:
+		// Only a partial date whose unknown components leave a single run of days, such as "? Mar 1850"
+		// or "18??", has the bounds of another type
+		if (td.M == 0 && td.D == 0) || (td.UnknownYearDigits == 0 && td.M != 0) {
+			return fromBounds(td.C, td.EarliestJulianDay(), td.LatestJulianDay())
+		}
+		return d
+	case qualifiedDay:
+		return fromQualifiedDay(d.Calendar(), td.julianDay(), td.after())
+	case ComparableDate:
+		return fromBounds(d.Calendar(), td.EarliestJulianDay(), td.LatestJulianDay())
+	}
+	return d
+}
+
+// normalizeSet normalizes each date in a set, orders them and removes any duplicates
+func normalizeSet(dates []Date) []Date {
+	norm := make([]Date, 0, len(dates))
+	for _, d := range dates {
+		nd := Normalize(d)
+		dup := false
+		for _, od := range norm {
+			if reflect.DeepEqual(nd, od) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			norm = append(norm, nd)
+		}
+	}
+	sort.SliceStable(norm, func(i, j int) bool {
+		return SortsBefore(norm[i], norm[j])
+	})
+	return norm
+}
+
+// fromBounds returns the most specific date in calendar c that falls between the Julian days lo and hi
+func fromBounds(c Calendar, lo, hi int) Date {
+	y1, m1, d1 := c.FromJulianDay(lo)
+	y2, m2, d2 := c.FromJulianDay(hi)
+	between := &BetweenPrecise{C: c, StartYear: y1, StartMonth: m1, StartDay: d1, EndYear: y2, EndMonth: m2, EndDay: d2}
+	var d ComparableDate
+	switch {
+	case lo == hi:
+		return &Precise{C: c, Y: y1, M: m1, D: d1}
+	case lo == yearStart(c, y1) && hi == yearEnd(c, y2):
+		switch {
+		case y1 == y2:
+			return &Year{C: c, Y: y1}
+		case y1%10 == 0 && y2 == y1+9:
+			return &Decade{C: c, Y: y1}
+		case y1%100 == 0 && y2 == y1+99:
+			return &Century{C: c, N: y1/100 + 1}
+		}
+		return &YearRange{C: c, Lower: y1, Upper: y2}
+	case d1 != 1 || d2 != c.DaysInMonth(y2, m2):
+		return between
+	case y1 == y2 && m1 == m2:
+		d = &MonthYear{C: c, Y: y1, M: m1}
+	case y1 == y2 && m1%3 == 1 && m2 == m1+2:
+		d = &YearQuarter{C: c, Y: y1, Q: m2 / 3}
+	default:
+		d = &MonthYearRange{C: c, LowerYear: y1, LowerMonth: m1, UpperYear: y2, UpperMonth: m2}
+	}
+	// Months in the Julian25Mar calendar that span 25 Mar do not have the bounds of their days
+	if d.EarliestJulianDay() != lo || d.LatestJulianDay() != hi {
+		return between
+	}
+	return d.(Date)
+}
+
+// fromQualifiedDay returns the most specific date in calendar c that is before or after the Julian day jd
+func fromQualifiedDay(c Calendar, jd int, after bool) Date {
+	y, m, d := c.FromJulianDay(jd)
+	dates := []qualifiedDay{
+		&BeforeYear{C: c, Y: y},
+		&BeforeMonthYear{C: c, Y: y, M: m},
+		&BeforePrecise{C: c, Y: y, M: m, D: d},
+	}
+	if after {
+		dates = []qualifiedDay{
+			&AfterYear{C: c, Y: y},
+			&AfterMonthYear{C: c, Y: y, M: m},
+			&AfterPrecise{C: c, Y: y, M: m, D: d},
+		}
+	}
+	for _, qd := range dates {
+		if qd.julianDay() == jd {
+			return qd.(Date)
+		}
+	}
+	return dates[len(dates)-1].(Date)
+}
@@ -0,0 +1,76 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		d    Date
+		want Date
+	}{
+		{d: &Precise{Y: 1850, M: 3, D: 5}, want: &Precise{Y: 1850, M: 3, D: 5}},
+		{d: &BetweenPrecise{StartYear: 1850, StartMonth: 3, StartDay: 5, EndYear: 1850, EndMonth: 3, EndDay: 5}, want: &Precise{Y: 1850, M: 3, D: 5}},
+		{d: &QuarterDay{Y: 1720, Q: 1}, want: &Precise{Y: 1720, M: 3, D: 25}},
+		{d: &YearRange{Lower: 1850, Upper: 1850}, want: &Year{Y: 1850}},
+		{d: &MonthYearRange{LowerYear: 1850, LowerMonth: 1, UpperYear: 1850, UpperMonth: 12}, want: &Year{Y: 1850}},
+		{d: &MonthYearRange{LowerYear: 1850, LowerMonth: 1, UpperYear: 1850, UpperMonth: 3}, want: &YearQuarter{Y: 1850, Q: 1}},
+		{d: &MonthYearRange{LowerYear: 1850, LowerMonth: 6, UpperYear: 1850, UpperMonth: 6}, want: &MonthYear{Y: 1850, M: 6}},
+		{d: &MonthYearRange{LowerYear: 1850, LowerMonth: 2, UpperYear: 1850, UpperMonth: 4}, want: &MonthYearRange{LowerYear: 1850, LowerMonth: 2, UpperYear: 1850, UpperMonth: 4}},
+		{d: &BetweenPrecise{StartYear: 1850, StartMonth: 10, StartDay: 1, EndYear: 1850, EndMonth: 12, EndDay: 31}, want: &YearQuarter{Y: 1850, Q: 4}},
+		{d: &BetweenPrecise{StartYear: 1850, StartMonth: 3, StartDay: 5, EndYear: 1850, EndMonth: 4, EndDay: 30}, want: &BetweenPrecise{StartYear: 1850, StartMonth: 3, StartDay: 5, EndYear: 1850, EndMonth: 4, EndDay: 30}},
+		{d: &Season{Y: 1850, S: SeasonWinter}, want: &MonthYearRange{LowerYear: 1850, LowerMonth: 12, UpperYear: 1851, UpperMonth: 2}},
+		{d: &YearPart{Y: 1850, Part: PartFirstHalf}, want: &MonthYearRange{LowerYear: 1850, LowerMonth: 1, UpperYear: 1850, UpperMonth: 6}},
+		{d: &YearRange{Lower: 1850, Upper: 1859}, want: &Decade{Y: 1850}},
+		{d: &Decade{Y: 1850, Part: PartEarly}, want: &YearRange{Lower: 1850, Upper: 1853}},
+		{d: &YearRange{Lower: 1800, Upper: 1899}, want: &Century{N: 19}},
+		{d: &YearRange{Lower: 1850, Upper: 1852}, want: &YearRange{Lower: 1850, Upper: 1852}},
+		{d: &Year{C: Julian25Mar, Y: 1700}, want: &Year{C: Julian25Mar, Y: 1700}},
+		{d: &Partial{Y: 1850, M: 3}, want: &MonthYear{Y: 1850, M: 3}},
+		{d: &Partial{Y: 1800, UnknownYearDigits: 2, NoDay: true}, want: &Century{N: 19}},
+		{d: &Partial{Y: 1800, M: 3, D: 5, UnknownYearDigits: 2}, want: &Partial{Y: 1800, M: 3, D: 5, UnknownYearDigits: 2}},
+		{d: &BeforePrecise{Y: 1850, M: 1, D: 1}, want: &BeforeYear{Y: 1850}},
+		{d: &BeforeQuarter{Y: 1850, Q: 3}, want: &BeforeMonthYear{Y: 1850, M: 7}},
+		{d: &BeforePrecise{Y: 1850, M: 3, D: 5}, want: &BeforePrecise{Y: 1850, M: 3, D: 5}},
+		{d: &AfterQuarter{Y: 1850, Q: 4}, want: &AfterYear{Y: 1850}},
+		{d: &AfterPrecise{Y: 1850, M: 2, D: 28}, want: &AfterMonthYear{Y: 1850, M: 2}},
+		{d: &AboutYear{Y: 1850}, want: &AboutYear{Y: 1850}},
+		{d: &Unknown{Text: "not a date"}, want: &Unknown{Text: "not a date"}},
+		{d: &OneOf{Dates: []Date{&Year{Y: 1851}, &YearRange{Lower: 1850, Upper: 1850}, &Year{Y: 1851}}}, want: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}}},
+		{d: &AllOf{Dates: []Date{&Year{Y: 1850}, &YearRange{Lower: 1850, Upper: 1850}}}, want: &Year{Y: 1850}},
+		{d: &Period{Start: &YearRange{Lower: 1850, Upper: 1850}}, want: &Period{Start: &Year{Y: 1850}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Normalize(tc.d)); diff != "" {
+				t.Errorf("Normalize(%s) mismatch (-want +got):\n%s", tc.d, diff)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want bool
+	}{
+		{a: &Year{Y: 1850}, b: &YearRange{Lower: 1850, Upper: 1850}, want: true},
+		{a: &MonthYearRange{LowerYear: 1850, LowerMonth: 1, UpperYear: 1850, UpperMonth: 3}, b: &YearQuarter{Y: 1850, Q: 1}, want: true},
+		{a: &BeforeYear{Y: 1850}, b: &BeforePrecise{Y: 1850, M: 1, D: 1}, want: true},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}}, b: &OneOf{Dates: []Date{&Year{Y: 1851}, &Year{Y: 1850}}}, want: true},
+		{a: &Year{Y: 1850}, b: &Year{Y: 1851}, want: false},
+		{a: &Year{Y: 1850}, b: &AboutYear{Y: 1850}, want: false},
+		{a: &Year{Y: 1850}, b: &Year{C: Julian, Y: 1850}, want: false},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}}, b: &AllOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}}, want: false},
+		{a: nil, b: nil, want: true},
+	}
+
+	for _, tc := range testCases {
+		if got := Equal(tc.a, tc.b); got != tc.want {
+			t.Errorf("Equal(%v, %v)=%v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
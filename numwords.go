@@ -0,0 +1,98 @@
+package gdate
+
+// A numberWord is a word used to write a number in English or Latin
+type numberWord struct {
+	n       int  // the value of the word, or the multiplier for words such as hundred and thousand
+	scale   bool // the word multiplies the number before it, as hundred and thousand do
+	ordinal bool // the word is an ordinal such as fifth or quinto
+}
+
+func cardinalWord(n int) numberWord { return numberWord{n: n} }
+func ordinalWord(n int) numberWord  { return numberWord{n: n, ordinal: true} }
+func scaleWord(n int) numberWord    { return numberWord{n: n, scale: true} }
+
+// numberWords maps the English and Latin words for numbers to their values. Latin ordinals are given in the
+// ablative form used in dates, as in "quinto die Martii anno Domini millesimo octingentesimo quinquagesimo".
+var numberWords = map[string]numberWord{
+	// English cardinals
+	"one": cardinalWord(1), "two": cardinalWord(2), "three": cardinalWord(3), "four": cardinalWord(4), "five": cardinalWord(5),
+	"six": cardinalWord(6), "seven": cardinalWord(7), "eight": cardinalWord(8), "nine": cardinalWord(9), "ten": cardinalWord(10),
+	"eleven": cardinalWord(11), "twelve": cardinalWord(12), "thirteen": cardinalWord(13), "fourteen": cardinalWord(14),
+	"fifteen": cardinalWord(15), "sixteen": cardinalWord(16), "seventeen": cardinalWord(17), "eighteen": cardinalWord(18),
+	"nineteen": cardinalWord(19), "twenty": cardinalWord(20), "thirty": cardinalWord(30), "forty": cardinalWord(40),
+	"fifty": cardinalWord(50), "sixty": cardinalWord(60), "seventy": cardinalWord(70), "eighty": cardinalWord(80),
+	"ninety": cardinalWord(90), "hundred": scaleWord(100), "thousand": scaleWord(1000),
+
+	// English ordinals
+	"first": ordinalWord(1), "second": ordinalWord(2), "third": ordinalWord(3), "fourth": ordinalWord(4), "fifth": ordinalWord(5),
+	"sixth": ordinalWord(6), "seventh": ordinalWord(7), "eighth": ordinalWord(8), "ninth": ordinalWord(9), "tenth": ordinalWord(10),
+	"eleventh": ordinalWord(11), "twelfth": ordinalWord(12), "thirteenth": ordinalWord(13), "fourteenth": ordinalWord(14),
+	"fifteenth": ordinalWord(15), "sixteenth": ordinalWord(16), "seventeenth": ordinalWord(17), "eighteenth": ordinalWord(18),
+	"nineteenth": ordinalWord(19), "twentieth": ordinalWord(20), "thirtieth": ordinalWord(30), "fortieth": ordinalWord(40),
+	"fiftieth": ordinalWord(50), "sixtieth": ordinalWord(60), "seventieth": ordinalWord(70), "eightieth": ordinalWord(80),
+	"ninetieth": ordinalWord(90),
+
+	// Latin cardinals
+	"unus": cardinalWord(1), "duo": cardinalWord(2), "tres": cardinalWord(3), "quattuor": cardinalWord(4), "quinque": cardinalWord(5),
+	"sex": cardinalWord(6), "septem": cardinalWord(7), "octo": cardinalWord(8), "novem": cardinalWord(9), "decem": cardinalWord(10),
+	"undecim": cardinalWord(11), "duodecim": cardinalWord(12), "tredecim": cardinalWord(13), "quattuordecim": cardinalWord(14),
+	"quindecim": cardinalWord(15), "sedecim": cardinalWord(16), "septendecim": cardinalWord(17), "duodeviginti": cardinalWord(18),
+	"undeviginti": cardinalWord(19), "viginti": cardinalWord(20), "triginta": cardinalWord(30), "quadraginta": cardinalWord(40),
+	"quinquaginta": cardinalWord(50), "sexaginta": cardinalWord(60), "septuaginta": cardinalWord(70), "octoginta": cardinalWord(80),
+	"nonaginta": cardinalWord(90), "centum": cardinalWord(100), "ducenti": cardinalWord(200), "trecenti": cardinalWord(300),
+	"quadringenti": cardinalWord(400), "quingenti": cardinalWord(500), "sescenti": cardinalWord(600), "septingenti": cardinalWord(700),
+	"octingenti": cardinalWord(800), "nongenti": cardinalWord(900), "mille": scaleWord(1000),
+
+	// Latin ordinals
+	"primo": ordinalWord(1), "secundo": ordinalWord(2), "tertio": ordinalWord(3), "quarto": ordinalWord(4), "quinto": ordinalWord(5),
+	"sexto": ordinalWord(6), "septimo": ordinalWord(7), "octavo": ordinalWord(8), "nono": ordinalWord(9), "decimo": ordinalWord(10),
+	"undecimo": ordinalWord(11), "duodecimo": ordinalWord(12), "duodevicesimo": ordinalWord(18), "undevicesimo": ordinalWord(19),
+	"vicesimo": ordinalWord(20), "vigesimo": ordinalWord(20), "tricesimo": ordinalWord(30), "trigesimo": ordinalWord(30),
+	"quadragesimo": ordinalWord(40), "quinquagesimo": ordinalWord(50), "sexagesimo": ordinalWord(60),
+	"septuagesimo": ordinalWord(70), "octogesimo": ordinalWord(80), "nonagesimo": ordinalWord(90),
+	"centesimo": ordinalWord(100), "ducentesimo": ordinalWord(200), "trecentesimo": ordinalWord(300),
+	"quadringentesimo": ordinalWord(400), "quingentesimo": ordinalWord(500), "sescentesimo": ordinalWord(600),
+	"sexcentesimo": ordinalWord(600), "septingentesimo": ordinalWord(700), "octingentesimo": ordinalWord(800),
+	"nongentesimo": ordinalWord(900), "millesimo": {n: 1000, scale: true, ordinal: true},
+}
+
+// wordNumber parses a number written in words starting at token i, such as "twenty-first",
+// "one thousand eight hundred and fifty", "eighteen fifty" or "millesimo octingentesimo quinquagesimo".
+// It returns the value of the number, whether it was written as an ordinal and the index of the
+// following token.
+func (g *grammar) wordNumber(i int) (int, bool, int, bool) {
+	total, current := 0, 0
+	ordinal, found := false, false
+	next := i
+	for j := i; j < len(g.toks); j++ {
+		t := g.toks[j]
+		if found && (t.is("and") || t.is("et") || t.is("-")) {
+			continue
+		}
+		if t.kind != tokWord {
+			break
+		}
+		w, ok := lookup(numberWords, t.text)
+		if !ok {
+			break
+		}
+		switch {
+		case w.scale && w.n == 1000:
+			total += max(current, 1) * 1000
+			current = 0
+		case w.scale:
+			current = max(current, 1) * w.n
+		case current > 0 && current < 10 && w.n == 10:
+			// Latin teens may be written units first, as in "tertio decimo"
+			current += w.n
+		case current%10 != 0 || (w.n >= 10 && w.n < 100 && current%100 >= 10):
+			// Years are often written as pairs of numbers, as in "eighteen fifty"
+			current = current*100 + w.n
+		default:
+			current += w.n
+		}
+		ordinal, found = w.ordinal, true
+		next = j + 1
+	}
+	return total + current, ordinal, next, found
+}
@@ -1,61 +1,30 @@
 package gdate
 
 import (
-	"regexp"
-	"strconv"
+	"fmt"
+	"strings"
 	"time"
 )
 
-const (
-	janAlts = `jan|jan\.|january|janry`
-	febAlts = `feb|feb\.|february|febry`
-	marAlts = `mar|mar\.|march`
-	aprAlts = `apr|apr\.|april`
-	mayAlts = `may`
-	junAlts = `jun|jun\.|june`
-	julAlts = `jul|jul\.|july`
-	augAlts = `aug|aug\.|august`
-	sepAlts = `sep|sep\.|september`
-	octAlts = `oct|oct\.|october`
-	novAlts = `nov|nov\.|november`
-	decAlts = `dec|dec\.|december`
-)
-
-var (
-	reYear        = regexp.MustCompile(`^\d{4}$`)
-	reBeforeYear  = regexp.MustCompile(`(?i)^bef(?:.|ore)?\s+(\d{4})\s*$`)
-	reAfterYear   = regexp.MustCompile(`(?i)^aft(?:.|er)?\s+(\d{4})\s*$`)
-	reAboutYear   = regexp.MustCompile(`(?i)^(?:abt|abt.|about)?\s+(\d{4})\s*$`)
-	reQuarterPost = regexp.MustCompile(`(?i)^(\d{4})\s*q([1-4])\s*$`)
-	reYearRange   = regexp.MustCompile(`^(\d{4})-(\d{4})$`)
-
-	reQuarter = [4]*regexp.Regexp{
-		regexp.MustCompile(`(?i)^(?:` + marAlts + `|q1|` + janAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + junAlts + `|q2|` + aprAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + sepAlts + `|q3|` + julAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + decAlts + `|q4|` + octAlts + `)?\s+(\d{4})\s*$`),
-	}
-
-	reMonthYearYM = regexp.MustCompile(`^(\d{4})-((?:0[1-9]|1[0-2]|[1-9]))$`)
-	reMonthYearMY = regexp.MustCompile(`^((?:0[1-9]|1[0-2]|[1-9]))-(\d{4})$`)
-
-	reMonthYearNamed = [12]*regexp.Regexp{
-		regexp.MustCompile(`(?i)^(?:` + janAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + febAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + marAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + aprAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + mayAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + junAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + julAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + augAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + sepAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + octAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + novAlts + `)?\s+(\d{4})\s*$`),
-		regexp.MustCompile(`(?i)^(?:` + decAlts + `)?\s+(\d{4})\s*$`),
-	}
-)
-
-var dateFormats = []string{"_2 Jan 2006", "_2 January 2006", "_2 Jan, 2006", "_2 January, 2006", "January _2 2006", "Jan _2 2006", "Jan _2, 2006", "2006-01-02"}
+// monthWords maps the words that may be used for months to the number of the month
+var monthWords = map[string]int{
+	"jan": 1, "january": 1, "janry": 1,
+	"feb": 2, "february": 2, "febry": 2,
+	"mar": 3, "march": 3,
+	"apr": 4, "april": 4,
+	"may": 5,
+	"jun": 6, "june": 6,
+	"jul": 7, "july": 7,
+	"aug": 8, "august": 8,
+	"sep": 9, "sept": 9, "september": 9,
+	"oct": 10, "october": 10,
+	"nov": 11, "november": 11,
+	"dec": 12, "december": 12,
+
+	// Latin month names in the genitive, as in "quinto die Martii"
+	"januarii": 1, "ianuarii": 1, "februarii": 2, "martii": 3, "aprilis": 4, "maii": 5, "junii": 6, "iunii": 6,
+	"julii": 7, "iulii": 7, "augusti": 8, "septembris": 9, "octobris": 10, "novembris": 11, "decembris": 12,
+}
 
 var defaultParser = Parser{}
 
@@ -83,219 +52,948 @@ type Parser struct {
 
 	// Calendar specifies the calendar to use for the date if ReckoningLocation is set to ReckoningLocationNone.
 	Calendar Calendar
+
+	// Hemisphere determines the months covered by seasons such as "Spring 1850".
+	Hemisphere Hemisphere
+
+	// TwoDigitYearPivot enables the expansion of two digit years such as those in "5 Mar 52" or "Mar '52".
+	// A two digit year is expanded to the latest year ending in those digits that is not after the pivot,
+	// so with a pivot of 1899 the year 52 is expanded to 1852 and 99 to 1899. Two digit years are
+	// not recognised when the pivot is zero and ContextDate is nil.
+	TwoDigitYearPivot int
+
+	// ContextDate is the date of the record containing the dates being parsed, such as the date of a
+	// census. When set, its year is used in place of TwoDigitYearPivot so that two digit years are
+	// expanded to the latest year that is not after the record was made.
+	ContextDate Date
+
+	// Fuzzy enables tolerant parsing of text produced by OCR or transcription. When a string cannot be
+	// parsed it is corrected and parsed again: letters mistaken for digits such as the "l" in "l850" are
+	// replaced, words split by a space such as "Mar ch" are joined, misspelt month names are replaced by
+	// the closest month and stray punctuation is ignored. Each correction is reported as a warning.
+	Fuzzy bool
+}
+
+// A Warning describes an assumption or correction made by the parser that may need to be checked.
+type Warning struct {
+	Kind    WarningKind
+	Message string
 }
 
+func (w Warning) String() string {
+	return w.Message
+}
+
+// WarningKind identifies the type of a Warning
+type WarningKind int
+
+const (
+	WarningTwoDigitYear    WarningKind = 1 // a two digit year was expanded to four digits
+	WarningWeekdayMismatch WarningKind = 2 // the weekday given in the date does not match the day of the week of the date
+	WarningTrailingText    WarningKind = 3 // text following the date was ignored
+	WarningAssumedCalendar WarningKind = 4 // the calendar was assumed from the reckoning location
+	WarningCorrected       WarningKind = 5 // the text was corrected before it could be parsed
+)
+
 // Parse uses heuristics to parse s into the highest precision date available.
 // An Unknown date is returned for any string that does not contain a detectable date.
 func (p *Parser) Parse(s string) (Date, error) {
-	for _, f := range dateFormats {
-		if t, err := time.Parse(f, s); err == nil {
-			return &Precise{
-				C: p.calendar(t.Year()),
-				Y: t.Year(),
-				M: int(t.Month()),
-				D: t.Day(),
-			}, nil
-		}
+	d, _, err := p.ParseWithWarnings(s)
+	return d, err
+}
+
+// ParseWithWarnings parses s in the same way as Parse but also returns warnings describing
+// any assumptions the parser made, such as the century of a two digit year.
+func (p *Parser) ParseWithWarnings(s string) (Date, []Warning, error) {
+	st := newParseState()
+	d := p.parseInput(s, st)
+	return d, st.warnings, nil
+}
+
+// parseState records information about the interpretation of a date while it is being parsed
+type parseState struct {
+	warnings     []Warning
+	rule         Rule
+	confidence   float64
+	alternatives []Alternative
+	assumptions  []Assumption
+	parts        []partAlternative
+}
+
+func newParseState() *parseState {
+	return &parseState{confidence: 1}
+}
+
+// merge adds the information recorded in other to st
+func (st *parseState) merge(other *parseState) {
+	st.warnings = append(st.warnings, other.warnings...)
+	st.rule = other.rule
+	st.confidence *= other.confidence
+	st.alternatives = append(st.alternatives, other.alternatives...)
+	st.assumptions = append(st.assumptions, other.assumptions...)
+}
+
+func (st *parseState) assume(kind AssumptionKind, format string, args ...any) {
+	st.assumptions = append(st.assumptions, Assumption{
+		Kind:        kind,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// choose records that the parser chose one interpretation over the alternatives. The chosen interpretation
+// keeps share of the current confidence and each alternative is scored by its share of the current confidence.
+func (st *parseState) choose(share float64, alts ...Alternative) {
+	for _, alt := range alts {
+		alt.Score *= st.confidence
+		st.alternatives = append(st.alternatives, alt)
 	}
+	st.confidence *= share
+}
+
+func (st *parseState) warn(kind WarningKind, format string, args ...any) {
+	st.warnings = append(st.warnings, Warning{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
 
-	if reYear.MatchString(s) {
-		y, err := strconv.Atoi(s)
-		if err != nil {
-			return nil, err
+// parseInput parses s, returning an Unknown date if s does not contain a recognisable date.
+func (p *Parser) parseInput(s string, st *parseState) Date {
+	var buf [16]token
+	if d := p.parseTokens(s, appendTokens(buf[:0], s), st); d != nil {
+		return d
+	}
+	if p.Fuzzy {
+		if d := p.parseFuzzy(s, st); d != nil {
+			return d
 		}
-		return &Year{
-			C: p.calendar(y),
-			Y: y,
-		}, nil
+	}
+	return &Unknown{Text: s}
+}
+
+// parseTokens parses a sequence of tokens taken from s, falling back to forms that require assumptions
+// or checks such as a leading weekday or a two digit year when the tokens cannot be parsed directly.
+// It returns nil if the tokens do not form a date.
+func (p *Parser) parseTokens(s string, toks []token, st *parseState) Date {
+	g := grammar{p: p, st: st, s: s, toks: toks}
+	if d := g.parse(); d != nil {
+		return d
 	}
 
-	m := reBeforeYear.FindStringSubmatch(s)
-	if len(m) > 1 {
-		y, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+	// A leading weekday, as in "Tuesday 5 March 1850", is checked against the date that follows
+	if len(toks) > 1 && toks[0].kind == tokWord {
+		if wd, ok := lookup(weekdayNames, toks[0].text); ok {
+			rest := toks[1:]
+			if rest[0].is(".") {
+				rest = rest[1:]
+			}
+			if len(rest) > 0 && rest[0].is(",") {
+				rest = rest[1:]
+			}
+			wst := newParseState()
+			if pd, ok := p.parseTokens(s, rest, wst).(*Precise); ok {
+				st.merge(wst)
+				if actual := pd.Weekday(); actual != wd {
+					st.warn(WarningWeekdayMismatch, "%s was a %s, not a %s", pd, actual, wd)
+					st.confidence *= 0.5
+				}
+				return pd
+			}
 		}
-		return &BeforeYear{
-			C: p.calendar(y - 1),
-			Y: y,
-		}, nil
+	}
+
+	// A two digit year, as in "5 Mar 52" or "Mar '52", must end the date and be separated from the
+	// rest of it by whitespace or a comma
+	n := len(toks)
+	if n < 2 || toks[n-1].kind != tokNumber || len(toks[n-1].text) != 2 {
+		return nil
+	}
+	yy := toks[n-1].num
+	y, ok := p.expandTwoDigitYear(yy)
+	if !ok {
+		return nil
+	}
+	k := n - 1
+	if toks[k-1].is("'") {
+		k--
+	}
+	if k == 0 || (toks[k-1].end() == toks[k].pos && !toks[k-1].is(",")) {
+		return nil
+	}
+	head := toks[:k]
+	if head[k-1].is(",") {
+		head = head[:k-1]
+	}
+	if len(head) == 0 {
+		return nil
+	}
 
+	var buf [16]token
+	withYear := func(y int) []token {
+		yt := toks[n-1]
+		yt.num = y
+		yt.expanded = true
+		return append(append(buf[:0], head...), yt)
 	}
 
-	m = reAfterYear.FindStringSubmatch(s)
-	if len(m) > 1 {
-		y, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+	eg := grammar{p: p, st: st, s: s, toks: withYear(y)}
+	d := eg.parse()
+	if d == nil {
+		return nil
+	}
+	st.warn(WarningTwoDigitYear, "two digit year %02d assumed to be %d", yy, y)
+	st.assume(AssumptionTwoDigitYear, "two digit year %02d assumed to be %d", yy, y)
+	var alts []Alternative
+	for _, ay := range []int{y - 100, y + 100} {
+		ag := grammar{p: p, st: newParseState(), s: s, toks: withYear(ay)}
+		if ad := ag.parse(); ad != nil {
+			alts = append(alts, Alternative{Date: ad, Rule: st.rule, Score: 0.1})
 		}
-		return &AfterYear{
-			C: p.calendar(y + 1),
-			Y: y,
-		}, nil
-
 	}
+	st.choose(0.8, alts...)
+	return d
+}
 
-	m = reAboutYear.FindStringSubmatch(s)
-	if len(m) > 1 {
-		y, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+// expandTwoDigitYear expands yy into the latest year ending in yy that is not after the parser's
+// context date or pivot year. It returns false if two digit years should not be expanded.
+func (p *Parser) expandTwoDigitYear(yy int) (int, bool) {
+	limit := p.TwoDigitYearPivot
+	if p.ContextDate != nil {
+		if cy, ok := AsYear(p.ContextDate); ok {
+			limit = cy.Y
 		}
-		return &AboutYear{
-			C: p.calendar(y),
-			Y: y,
-		}, nil
+	}
+	if limit <= 0 {
+		return 0, false
+	}
+
+	y := limit - limit%100 + yy
+	if y > limit {
+		y -= 100
+	}
+	return y, true
+}
+
+// A grammar recognises the forms of date in a sequence of tokens. Each form must account for every
+// token in the sequence and rejects it as soon as a token does not fit, so no text is scanned more
+// than once.
+type grammar struct {
+	p    *Parser
+	st   *parseState
+	s    string // the text containing the tokens
+	toks []token
+	item bool // the tokens are part of a larger date, such as an item of a list, so cannot be a list or period
+}
+
+// parse returns the date formed by the tokens, or nil if they do not form a date.
+func (g *grammar) parse() Date {
+	if len(g.toks) == 0 {
+		return nil
+	}
+	if d := g.precise(); d != nil {
+		return d
+	}
+	if d := g.numeric(); d != nil {
+		return d
+	}
+	if d := g.partial(); d != nil {
+		return d
+	}
+	if d := g.qualified(); d != nil {
+		return d
+	}
+	if d := g.monthYear(); d != nil {
+		return d
+	}
+	if d := g.part(); d != nil {
+		return d
+	}
+	if d := g.season(); d != nil {
+		return d
+	}
+	if d := g.quarterDay(); d != nil {
+		return d
+	}
+	if d := g.relative(); d != nil {
+		return d
+	}
+	if d := g.feast(); d != nil {
+		return d
+	}
+	if d := g.period(); d != nil {
+		return d
+	}
+	return g.list()
+}
+
+// text returns a copy of the text spanned by the tokens. Copying the text when it is needed, rather
+// than referring to the input, allows the tokens of the input to be kept on the stack.
+func (g *grammar) text() string {
+	return strings.Clone(g.s[g.toks[0].pos:g.toks[len(g.toks)-1].end()])
+}
 
+// at reports whether token i is the word or punctuation w, ignoring case
+func (g *grammar) at(i int, w string) bool {
+	return i < len(g.toks) && g.toks[i].is(w)
+}
+
+// opt returns the index of the token following an optional word or punctuation w at token i
+func (g *grammar) opt(i int, w string) int {
+	if g.at(i, w) {
+		return i + 1
+	}
+	return i
+}
+
+// number returns the value of token i if it is a number of no more than n digits
+func (g *grammar) number(i, n int) (int, bool) {
+	if i >= len(g.toks) || g.toks[i].kind != tokNumber || g.toks[i].expanded || len(g.toks[i].text) > n {
+		return 0, false
+	}
+	return g.toks[i].num, true
+}
+
+// year returns the value of token i if it is a four digit year
+func (g *grammar) year(i int) (int, bool) {
+	if i >= len(g.toks) || g.toks[i].kind != tokNumber || (len(g.toks[i].text) != 4 && !g.toks[i].expanded) {
+		return 0, false
+	}
+	return g.toks[i].num, true
+}
+
+// lastYear returns the value of token i if it is a four digit year and the last token
+func (g *grammar) lastYear(i int) (int, bool) {
+	if i != len(g.toks)-1 {
+		return 0, false
+	}
+	return g.year(i)
+}
+
+// month returns the number of the month named by token i and the index of the following token,
+// skipping any full stop after an abbreviation.
+func (g *grammar) month(i int) (int, int, bool) {
+	if i >= len(g.toks) || g.toks[i].kind != tokWord {
+		return 0, 0, false
+	}
+	m, ok := lookup(monthWords, g.toks[i].text)
+	if !ok {
+		return 0, 0, false
 	}
+	return m, g.opt(i+1, "."), true
+}
+
+// ordinalSuffix reports whether token i is the suffix of an ordinal number such as the "th" of "19th"
+func (g *grammar) ordinalSuffix(i int) bool {
+	return g.at(i, "st") || g.at(i, "nd") || g.at(i, "rd") || g.at(i, "th")
+}
 
-	if p.AssumeGROQuarter {
-		d, err := p.tryParseQuarter(s)
-		if err != nil {
-			return nil, err
+// precise parses a day, month and year such as "5 Mar 1850", "March 5th, 1850", "1850-03-05",
+// "the fifth day of March in the year of our Lord one thousand eight hundred and fifty" or
+// "quinto die Martii anno Domini millesimo octingentesimo quinquagesimo".
+func (g *grammar) precise() Date {
+	var y, m, d int
+	if yy, ok := g.year(0); ok && g.at(1, "-") {
+		mm, mok := g.number(2, 2)
+		dd, dok := g.number(4, 2)
+		if !mok || !dok || !g.at(3, "-") || len(g.toks) != 5 {
+			return nil
 		}
-		if d != nil {
-			return d, nil
+		y, m, d = yy, mm, dd
+	} else if dd, i, ok := g.day(g.opt(0, "the")); ok {
+		mm, i, ok := g.month(g.opt(i, "of"))
+		if !ok {
+			return nil
 		}
-
-		d, err = p.tryParseMonthYear(s)
-		if err != nil {
-			return nil, err
+		yy, ok := g.yearPhrase(g.opt(i, ","))
+		if !ok {
+			return nil
+		}
+		y, m, d = yy, mm, dd
+	} else if mm, i, ok := g.month(0); ok {
+		dd, i, ok := g.day(g.opt(i, "the"))
+		if !ok {
+			return nil
+		}
+		yy, ok := g.yearPhrase(g.opt(i, ","))
+		if !ok {
+			return nil
 		}
-		if d != nil {
-			return d, nil
+		y, m, d = yy, mm, dd
+	} else {
+		return nil
+	}
+
+	c := g.p.calendar(y)
+	if m < 1 || m > 12 || d < 1 || d > c.DaysInMonth(y, m) {
+		return nil
+	}
+	g.st.rule = RulePrecise
+	return &Precise{C: c, Y: y, M: m, D: d}
+}
+
+// day parses a day of the month at token i written as a number, an ordinal such as "5th" or an ordinal
+// in words such as "fifth" or "quinto", optionally followed by "day". It returns the day and the index
+// of the following token.
+func (g *grammar) day(i int) (int, int, bool) {
+	d, ok := g.number(i, 2)
+	if ok {
+		i++
+		if g.ordinalSuffix(i) && g.toks[i].pos == g.toks[i-1].end() {
+			i++
 		}
 	} else {
-		d, err := p.tryParseMonthYear(s)
-		if err != nil {
-			return nil, err
+		var ordinal bool
+		d, ordinal, i, ok = g.wordNumber(i)
+		if !ok || !ordinal {
+			return 0, 0, false
 		}
-		if d != nil {
-			return d, nil
+	}
+	if g.at(i, "day") || g.at(i, "die") {
+		i++
+	}
+	return d, i, true
+}
+
+// yearPhrase parses a year at token i that ends the tokens, written as a number or in words such as
+// "one thousand eight hundred and fifty" and optionally introduced by "in the year of our Lord" or
+// "anno Domini".
+func (g *grammar) yearPhrase(i int) (int, bool) {
+	i = g.opt(i, "in")
+	if g.at(i, "the") && g.at(i+1, "year") {
+		i += 2
+		if g.at(i, "of") && g.at(i+1, "our") && g.at(i+2, "lord") {
+			i += 3
 		}
+	}
+	if g.at(i, "anno") {
+		i = g.opt(i+1, "domini")
+	}
+	if y, ok := g.lastYear(i); ok {
+		return y, true
+	}
+
+	// Years in words must be long enough not to be mistaken for a day or a two digit year
+	y, _, next, ok := g.wordNumber(i)
+	if !ok || next != len(g.toks) || y < 100 {
+		return 0, false
+	}
+	return y, true
+}
 
-		d, err = p.tryParseQuarter(s)
-		if err != nil {
-			return nil, err
+// partial parses dates with unknown components such as "5 Mar 18__", "?? Mar 1850", "5 ? 1850" or
+// "Mar 185?". A year alone with unknown digits, such as "185?" or "18__", is parsed as a decade or century.
+func (g *grammar) partial() Date {
+	if y, k, next, ok := g.partialYear(0); ok && k > 0 && next == len(g.toks) {
+		switch k {
+		case 1:
+			g.st.rule = RuleDecade
+			return &Decade{C: g.p.calendar(y), Y: y}
+		case 2:
+			g.st.rule = RuleCentury
+			return &Century{C: g.p.calendar(y), N: y/100 + 1}
 		}
-		if d != nil {
-			return d, nil
+		return nil
+	}
+
+	var d, m int
+	noDay := true
+	i := 0
+	if v, ok := g.number(0, 2); ok {
+		d, noDay, i = v, false, 1
+	} else if n, next := g.unknownRun(0); n > 0 && n <= 2 {
+		noDay, i = false, next
+	}
+	if v, next, ok := g.month(i); ok {
+		m, i = v, next
+	} else if n, next := g.unknownRun(i); n > 0 && !noDay {
+		i = next
+	} else {
+		return nil
+	}
+	y, k, next, ok := g.partialYear(g.opt(i, ","))
+	if !ok || next != len(g.toks) {
+		return nil
+	}
+
+	// Dates with nothing unknown are parsed by other rules
+	if k == 0 && m != 0 && (noDay || d != 0) {
+		return nil
+	}
+
+	c := g.p.calendar(y)
+	if d != 0 {
+		// The most days the month can have, since the year may be a leap year
+		maxDays := 31
+		if m != 0 {
+			maxDays = Gregorian.DaysInMonth(2000, m)
+			if k == 0 {
+				maxDays = c.DaysInMonth(y, m)
+			}
+		}
+		if d < 1 || d > maxDays {
+			return nil
 		}
+	}
+	g.st.rule = RulePartial
+	return &Partial{C: c, Y: y, M: m, D: d, UnknownYearDigits: k, NoDay: noDay}
+}
+
+// partialYear parses a year at token i in which trailing digits may be unknown, such as "18__" or "185?".
+// It returns the year with unknown digits set to zero, the number of unknown digits and the index of
+// the following token.
+func (g *grammar) partialYear(i int) (int, int, int, bool) {
+	if y, ok := g.year(i); ok {
+		return y, 0, i + 1, true
+	}
+	y, ok := g.number(i, 3)
+	if !ok {
+		return 0, 0, 0, false
+	}
+	n, next := g.unknownRun(i + 1)
+	if n == 0 || len(g.toks[i].text)+n != 4 || g.toks[i+1].pos != g.toks[i].end() {
+		return 0, 0, 0, false
+	}
+	return y * pow10(n), n, next, true
+}
 
+// unknownRun returns the number of adjacent question marks or underscores starting at token i, which
+// stand for unknown digits or components, and the index of the following token.
+func (g *grammar) unknownRun(i int) (int, int) {
+	n := 0
+	for j := i; j < len(g.toks) && isUnknownMark(g.toks[j]); j++ {
+		if j > i && g.toks[j].pos != g.toks[j-1].end() {
+			break
+		}
+		n++
 	}
+	return n, i + n
+}
+
+// isUnknownMark reports whether t is a question mark or underscore standing for an unknown digit or component
+func isUnknownMark(t token) bool {
+	return t.text == "?" || t.text == "_"
+}
 
-	m = reQuarterPost.FindStringSubmatch(s)
-	if len(m) > 1 {
-		y, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+// numeric parses forms that begin with a year: "1850", "1850-1855", "1850-03" and "1850Q1".
+func (g *grammar) numeric() Date {
+	y, ok := g.year(0)
+	if !ok {
+		return nil
+	}
+	switch {
+	case len(g.toks) == 1:
+		g.st.rule = RuleYear
+		return &Year{C: g.p.calendar(y), Y: y}
+	case len(g.toks) == 3 && g.at(1, "-"):
+		if upper, ok := g.year(2); ok {
+			g.st.rule = RuleYearRange
+			return &YearRange{C: g.p.calendar(y), Lower: y, Upper: upper}
+		}
+		if m, ok := g.number(2, 2); ok && m >= 1 && m <= 12 {
+			g.st.rule = RuleMonthYear
+			return &MonthYear{C: g.p.calendar(y), Y: y, M: m}
 		}
-		q, err := strconv.Atoi(m[2])
-		if err != nil {
-			return nil, err
+	case len(g.toks) == 3 && g.at(1, "q"):
+		if q, ok := g.number(2, 1); ok && q >= 1 && q <= 4 {
+			g.st.rule = RuleQuarter
+			return &YearQuarter{C: g.p.calendar(y), Y: y, Q: q}
 		}
-		return &YearQuarter{
-			C: p.calendar(y),
-			Y: y,
-			Q: q,
-		}, nil
+	}
+	return nil
+}
+
+// qualified parses years qualified by before, after or about such as "bef. 1850", and months, quarters
+// and days qualified by before or after such as "bef. Mar 1850", "after Jun qtr 1850" or
+// "before 5 Mar 1850".
+func (g *grammar) qualified() Date {
+	if len(g.toks) < 2 || g.toks[0].kind != tokWord {
+		return nil
+	}
+	i := g.opt(1, ".")
+	w := g.toks[0]
+	before := w.is("bef") || w.is("before")
+	after := w.is("aft") || w.is("after")
+	if y, ok := g.lastYear(i); ok {
+		switch {
+		case before:
+			g.st.rule = RuleBeforeYear
+			return &BeforeYear{C: g.p.calendar(y - 1), Y: y}
+		case after:
+			g.st.rule = RuleAfterYear
+			return &AfterYear{C: g.p.calendar(y + 1), Y: y}
+		case w.is("abt") || w.is("about"):
+			g.st.rule = RuleAboutYear
+			return &AboutYear{C: g.p.calendar(y), Y: y}
+		}
+		return nil
+	}
+	if !before && !after {
+		return nil
+	}
 
+	st := newParseState()
+	d := qualify(g.bounded(st, g.s, g.toks[i:]), before)
+	if d == nil {
+		return nil
+	}
+	rule := RuleAfterDate
+	if before {
+		rule = RuleBeforeDate
 	}
+	g.keep(st, rule, func(part, alt Date) Date { return qualify(alt, before) })
+	return d
+}
 
-	m = reYearRange.FindStringSubmatch(s)
-	if len(m) > 2 {
-		lower, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+// qualify returns the date before d if before is true, or after d otherwise. It returns nil if d is not
+// a precise date, month or quarter.
+func qualify(d Date, before bool) Date {
+	switch td := d.(type) {
+	case *MonthYear:
+		if before {
+			return &BeforeMonthYear{C: td.C, Y: td.Y, M: td.M}
 		}
-		upper, err := strconv.Atoi(m[2])
-		if err != nil {
-			return nil, err
+		return &AfterMonthYear{C: td.C, Y: td.Y, M: td.M}
+	case *YearQuarter:
+		if before {
+			return &BeforeQuarter{C: td.C, Y: td.Y, Q: td.Q}
 		}
-		return &YearRange{
-			C:     p.calendar(lower),
-			Lower: lower,
-			Upper: upper,
-		}, nil
+		return &AfterQuarter{C: td.C, Y: td.Y, Q: td.Q}
+	case *Precise:
+		if before {
+			return &BeforePrecise{C: td.C, Y: td.Y, M: td.M, D: td.D}
+		}
+		return &AfterPrecise{C: td.C, Y: td.Y, M: td.M, D: td.D}
+	}
+	return nil
+}
 
+// monthYear parses a month and year such as "Mar 1850" or "3-1850" and GRO quarters such as "Q1 1850" or
+// "Jun qtr 1850".
+// Months at the start or end of a quarter may refer to the GRO quarter containing them.
+func (g *grammar) monthYear() Date {
+	var my, q Date
+	if m, i, ok := g.month(g.opt(0, "the")); ok && (g.at(i, "qtr") || g.at(i, "quarter")) {
+		// GRO quarters named by the month that ends them, such as "Jun qtr 1850" or "the Dec quarter of 1850"
+		y, ok := g.lastYear(g.opt(g.opt(g.opt(i+1, "."), "of"), ","))
+		if !ok || m%3 != 0 {
+			return nil
+		}
+		q = &YearQuarter{C: g.p.calendar(y), Y: y, Q: m / 3}
+	} else if m, i, ok := g.month(0); ok {
+		y, ok := g.lastYear(g.opt(i, ","))
+		if !ok {
+			return nil
+		}
+		my = &MonthYear{C: g.p.calendar(y), Y: y, M: m}
+		if m%3 != 2 {
+			q = &YearQuarter{C: g.p.calendar(y), Y: y, Q: (m-1)/3 + 1}
+		}
+	} else if g.at(0, "q") {
+		n, ok := g.number(1, 1)
+		y, yok := g.lastYear(2)
+		if !ok || !yok || n < 1 || n > 4 {
+			return nil
+		}
+		q = &YearQuarter{C: g.p.calendar(y), Y: y, Q: n}
+	} else if m, ok := g.number(0, 2); ok && m >= 1 && m <= 12 && g.at(1, "-") {
+		y, ok := g.lastYear(2)
+		if !ok {
+			return nil
+		}
+		my = &MonthYear{C: g.p.calendar(y), Y: y, M: m}
+	} else {
+		return nil
 	}
 
-	return &Unknown{Text: s}, nil
+	if q != nil && (g.p.AssumeGROQuarter || my == nil) {
+		g.st.rule = RuleQuarter
+		if my != nil {
+			g.st.assume(AssumptionGROQuarter, "%s assumed to refer to the GRO quarter %s", g.text(), q)
+			g.st.choose(0.6, Alternative{Date: my, Rule: RuleMonthYear, Score: 0.4})
+		}
+		return q
+	}
+	g.st.rule = RuleMonthYear
+	if q != nil {
+		g.st.choose(0.7, Alternative{Date: q, Rule: RuleQuarter, Score: 0.3})
+	}
+	return my
 }
 
-func (p *Parser) tryParseQuarter(s string) (Date, error) {
-	for i, re := range reQuarter {
-		m := re.FindStringSubmatch(s)
-		if len(m) > 1 {
-			y, err := strconv.Atoi(m[1])
-			if err != nil {
-				return nil, err
+// part parses decades, centuries and parts of years, decades and centuries such as "1850s",
+// "19th century" or "first half of 1850". A decade ending in 00 such as 1800s is taken to refer
+// to the century.
+func (g *grammar) part() Date {
+	part, i := g.partPrefix()
+
+	// Decades such as "1850s" or "1850's"
+	if y, ok := g.number(i, 4); ok && len(g.toks[i].text) >= 2 && y%10 == 0 {
+		if j := g.opt(i+1, "'"); j == len(g.toks)-1 && g.at(j, "s") {
+			dec := &Decade{C: g.p.calendar(y), Y: y, Part: part}
+			if y%100 == 0 {
+				g.st.rule = RuleCentury
+				g.st.assume(AssumptionCentury, "%s assumed to refer to a century rather than a decade", g.text())
+				g.st.choose(0.7, Alternative{Date: dec, Rule: RuleDecade, Score: 0.3})
+				return &Century{C: g.p.calendar(y), N: y/100 + 1, Part: part}
 			}
-			return &YearQuarter{
-				C: p.calendar(y),
-				Y: y,
-				Q: i + 1,
-			}, nil
+			g.st.rule = RuleDecade
+			return dec
+		}
+	}
+
+	// Centuries such as "19th century" or "19th cent."
+	if n, ok := g.number(i, 2); ok && n > 0 && g.ordinalSuffix(i+1) && (g.at(i+2, "century") || g.at(i+2, "cent")) && g.opt(i+3, ".") == len(g.toks) {
+		g.st.rule = RuleCentury
+		return &Century{C: g.p.calendar((n - 1) * 100), N: n, Part: part}
+	}
 
+	// Parts of years such as "late 1850"
+	if part != PartWhole {
+		if y, ok := g.lastYear(i); ok {
+			g.st.rule = RuleYearPart
+			return &YearPart{C: g.p.calendar(y), Y: y, Part: part}
 		}
+	}
+
+	return nil
+}
 
+// partPrefix parses a qualifier such as "early", "mid-" or "first half of the" and returns the part
+// it names and the index of the following token.
+func (g *grammar) partPrefix() (Part, int) {
+	t := g.toks[0]
+	switch {
+	case t.is("early"):
+		return PartEarly, g.opt(1, "-")
+	case t.is("mid"):
+		return PartMid, g.opt(1, "-")
+	case t.is("late"):
+		return PartLate, g.opt(1, "-")
 	}
 
-	return nil, nil
+	var part Part
+	var i int
+	switch {
+	case t.is("first"):
+		part, i = PartFirstHalf, 1
+	case t.is("second"):
+		part, i = PartSecondHalf, 1
+	case t.text == "1" && g.at(1, "st"):
+		part, i = PartFirstHalf, 2
+	case t.text == "2" && g.at(1, "nd"):
+		part, i = PartSecondHalf, 2
+	default:
+		return PartWhole, 0
+	}
+	if !g.at(i, "half") || !g.at(i+1, "of") {
+		return PartWhole, 0
+	}
+	return part, g.opt(i+2, "the")
 }
 
-func (p *Parser) tryParseMonthYear(s string) (Date, error) {
-	m := reMonthYearYM.FindStringSubmatch(s)
-	if len(m) > 2 {
-		y, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
+// season parses seasons such as "Spring 1850" or "Winter 1850/51".
+func (g *grammar) season() Date {
+	if len(g.toks) < 2 || g.toks[0].kind != tokWord {
+		return nil
+	}
+	kind, ok := lookup(seasonWords, g.toks[0].text)
+	if !ok {
+		return nil
+	}
+	i := g.opt(1, "of")
+	y, ok := g.year(i)
+	if !ok {
+		return nil
+	}
+	se := &Season{
+		C: g.p.calendar(y),
+		Y: y,
+		S: kind,
+		H: g.p.Hemisphere,
+	}
+	_, _, spans := se.months()
+
+	switch len(g.toks) - i {
+	case 1:
+	case 3:
+		// A second year is only valid for seasons that span the end of the year
+		if !g.at(i+1, "/") && !g.at(i+1, "-") {
+			return nil
 		}
-		mo, err := strconv.Atoi(m[2])
-		if err != nil {
-			return nil, err
+		next, ok := g.number(i+2, 4)
+		if !ok || len(g.toks[i+2].text) == 3 || !spans || (next != y+1 && next != (y+1)%100) {
+			return nil
 		}
-		return &MonthYear{
-			C: p.calendar(y),
-			Y: y,
-			M: mo,
-		}, nil
+	default:
+		return nil
 	}
-	m = reMonthYearMY.FindStringSubmatch(s)
-	if len(m) > 2 {
-		mo, err := strconv.Atoi(m[1])
-		if err != nil {
-			return nil, err
-		}
-		y, err := strconv.Atoi(m[2])
-		if err != nil {
-			return nil, err
+
+	g.st.rule = RuleSeason
+	g.st.assume(AssumptionHemisphere, "seasons assumed to be those of the %s", g.p.Hemisphere)
+	if spans && len(g.toks)-i == 1 {
+		// "Winter 1850" may refer to the winter ending in 1850
+		prev := *se
+		prev.Y--
+		g.st.choose(0.6, Alternative{Date: &prev, Rule: RuleSeason, Score: 0.4})
+	}
+	return se
+}
+
+// quarterDay parses quarter days such as "Lady Day 1720" or "Michaelmas, 1850".
+func (g *grammar) quarterDay() Date {
+	if len(g.toks) < 2 || g.toks[0].kind != tokWord {
+		return nil
+	}
+	i := 1
+	q, ok := lookup(quarterDayNames, g.toks[0].text)
+	if !ok {
+		if !g.toks[0].is("lady") || !g.at(1, "day") {
+			return nil
 		}
-		return &MonthYear{
-			C: p.calendar(y),
-			Y: y,
-			M: mo,
-		}, nil
+		q, i = 1, 2
+	}
+	y, ok := g.lastYear(g.opt(g.opt(i, "day"), ","))
+	if !ok {
+		return nil
 	}
+	g.st.rule = RuleQuarterDay
+	return &QuarterDay{C: g.p.calendar(y), Y: y, Q: q}
+}
 
-	for i, re := range reMonthYearNamed {
-		m := re.FindStringSubmatch(s)
-		if len(m) > 1 {
-			y, err := strconv.Atoi(m[1])
-			if err != nil {
-				return nil, err
-			}
-			return &MonthYear{
-				C: p.calendar(y),
-				Y: y,
-				M: i + 1,
-			}, nil
+// maxRelativeDays is the largest number of days accepted in relative dates such as "the 10th day after Easter"
+const maxRelativeDays = 40
 
+// relative parses days relative to a feast or another date such as "the Tuesday after Michaelmas 1850",
+// "the third day after Easter 1612" or "the morrow of All Saints 1450".
+func (g *grammar) relative() Date {
+	i := g.opt(0, "the")
+	if i+2 >= len(g.toks) {
+		return nil
+	}
+	t := g.toks[i]
+
+	var n int // the number of days from the base date
+	var weekday time.Weekday
+	byWeekday := false
+	after := true
+	switch {
+	case t.is("morrow") && g.at(i+1, "of"):
+		n, i = 1, i+2
+	case (t.is("eve") || t.is("vigil")) && g.at(i+1, "of"):
+		n, after, i = 1, false, i+2
+	case t.is("octave") && g.at(i+1, "of"):
+		n, i = 7, i+2
+	default:
+		if wd, ok := lookup(weekdayNames, t.text); ok && t.kind == tokWord {
+			weekday, byWeekday = wd, true
+			i++
+		} else if t.is("day") {
+			n = 1
+			i++
+		} else if v, ok := g.number(i, 2); ok && v >= 1 && v <= maxRelativeDays {
+			n = v
+			i++
+			if g.ordinalSuffix(i) {
+				i++
+			}
+		} else if v, ordinal, next, ok := g.wordNumber(i); ok && ordinal && v >= 1 && v <= maxRelativeDays {
+			n, i = v, next
+		} else {
+			return nil
 		}
+		if g.at(i, "day") || g.at(i, "days") {
+			i++
+		}
+		i = g.opt(i, "next")
+		switch {
+		case g.at(i, "after"):
+		case g.at(i, "before"):
+			after = false
+		default:
+			return nil
+		}
+		i++
+	}
 
+	sub := grammar{p: g.p, st: newParseState(), s: g.s, toks: g.toks[i:]}
+	var jd int
+	var c Calendar
+	switch b := sub.parse().(type) {
+	case *Precise:
+		jd, c = b.EarliestJulianDay(), b.C
+	case *QuarterDay:
+		jd, c = b.EarliestJulianDay(), b.C
+	default:
+		return nil
 	}
 
-	return nil, nil
+	switch {
+	case byWeekday && after:
+		jd += int(weekday-weekdayOf(jd)+6)%7 + 1
+	case byWeekday:
+		jd -= int(weekdayOf(jd)-weekday+6)%7 + 1
+	case after:
+		jd += n
+	default:
+		jd -= n
+	}
+	g.st.rule = RuleRelativeDay
+	return preciseFromJulianDay(c, jd)
+}
+
+// feast parses feasts such as "Easter Monday 1612" or "the Feast of St Martin, 1450".
+func (g *grammar) feast() Date {
+	n := len(g.toks)
+	if n < 2 || g.toks[0].kind != tokWord {
+		return nil
+	}
+	y, ok := g.year(n - 1)
+	if !ok {
+		return nil
+	}
+	d, ok := FeastDay(strings.Clone(g.s[g.toks[0].pos:g.toks[n-1].pos]), g.p.calendar(y), y)
+	if !ok {
+		return nil
+	}
+	g.st.rule = RuleFeast
+	return d
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+	"sun":       time.Sunday,
+	"mon":       time.Monday,
+	"tue":       time.Tuesday,
+	"tues":      time.Tuesday,
+	"wed":       time.Wednesday,
+	"thu":       time.Thursday,
+	"thur":      time.Thursday,
+	"thurs":     time.Thursday,
+	"fri":       time.Friday,
+	"sat":       time.Saturday,
+}
+
+var seasonWords = map[string]SeasonKind{
+	"spring":  SeasonSpring,
+	"summer":  SeasonSummer,
+	"autumn":  SeasonAutumn,
+	"fall":    SeasonAutumn,
+	"winter":  SeasonWinter,
+	"harvest": SeasonHarvest,
+}
+
+var quarterDayNames = map[string]int{
+	"ladyday":    1,
+	"midsummer":  2,
+	"michaelmas": 3,
+	"christmas":  4,
+	"xmas":       4,
 }
 
 func (p *Parser) calendar(yr int) Calendar {
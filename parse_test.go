@@ -22,6 +22,57 @@ func TestParse(t *testing.T) {
 			s:    "23 Apr 1871",
 			want: &Precise{Y: 1871, M: 4, D: 23},
 		},
+		{
+			s:    "5 Sep 1850",
+			alts: []string{"5 Sept. 1850", "Sept 5, 1850", "1850-09-05", "1850-9-5", "5 SEPTEMBER 1850"},
+			want: &Precise{Y: 1850, M: 9, D: 5},
+		},
+		{
+			s:    "the 5th of March 1850",
+			alts: []string{"5th March 1850", "March 5th, 1850", "March the 5th 1850", "the 5th day of March, 1850", "the fifth of March 1850"},
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s: "the fifth day of March in the year of our Lord one thousand eight hundred and fifty",
+			alts: []string{
+				"fifth day of March in the year of our Lord eighteen hundred and fifty",
+				"5th March, in the year 1850",
+				"the fifth of March eighteen fifty",
+				"quinto die Martii anno Domini millesimo octingentesimo quinquagesimo",
+				"quinto die Martii 1850",
+			},
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "the twenty-first of June, eighteen hundred and fifty-two",
+			alts: []string{"twenty first June 1852", "vicesimo primo die Junii 1852", "21st June one thousand eight hundred fifty two"},
+			want: &Precise{Y: 1852, M: 6, D: 21},
+		},
+		{
+			s:    "tertio decimo die Maii 1650",
+			alts: []string{"decimo tertio die Maii 1650", "the thirteenth of May 1650"},
+			want: &Precise{Y: 1650, M: 5, D: 13},
+		},
+		{
+			s:    "the 31st of February 1850",
+			want: &Unknown{Text: "the 31st of February 1850"},
+		},
+		{
+			s:    "5 March fifty",
+			want: &Unknown{Text: "5 March fifty"},
+		},
+		{
+			s:    "29 Feb 1852",
+			want: &Precise{Y: 1852, M: 2, D: 29},
+		},
+		{
+			s:    "29 Feb 1850",
+			want: &Unknown{Text: "29 Feb 1850"},
+		},
+		{
+			s:    "31 Apr 1850",
+			want: &Unknown{Text: "31 Apr 1850"},
+		},
 		{
 			s:    "bef 1950",
 			alts: []string{"bef. 1950", "before 1950", "BEF 1950", "BEF. 1950", "BEFORE 1950"},
@@ -113,6 +164,273 @@ func TestParse(t *testing.T) {
 			s:    "1920-1923",
 			want: &YearRange{Lower: 1920, Upper: 1923},
 		},
+		{
+			s:    "1850s",
+			alts: []string{"1850's"},
+			want: &Decade{Y: 1850},
+		},
+		{
+			s:    "early 1850s",
+			alts: []string{"Early 1850s", "early-1850s"},
+			want: &Decade{Y: 1850, Part: PartEarly},
+		},
+		{
+			s:    "mid-1850s",
+			alts: []string{"mid 1850s"},
+			want: &Decade{Y: 1850, Part: PartMid},
+		},
+		{
+			s:    "first half of the 1850s",
+			want: &Decade{Y: 1850, Part: PartFirstHalf},
+		},
+		{
+			s:    "19th century",
+			alts: []string{"19th Century", "1800s", "19th cent."},
+			want: &Century{N: 19},
+		},
+		{
+			s:    "late 18th century",
+			want: &Century{N: 18, Part: PartLate},
+		},
+		{
+			s:    "mid-1700s",
+			want: &Century{N: 18, Part: PartMid},
+		},
+		{
+			s:    "second half of the 21st century",
+			want: &Century{N: 21, Part: PartSecondHalf},
+		},
+		{
+			s:    "first half of 1850",
+			alts: []string{"1st half of 1850"},
+			want: &YearPart{Y: 1850, Part: PartFirstHalf},
+		},
+		{
+			s:    "late 1850",
+			want: &YearPart{Y: 1850, Part: PartLate},
+		},
+		{
+			s:    "Spring 1850",
+			alts: []string{"spring 1850", "spring of 1850"},
+			want: &Season{Y: 1850, S: SeasonSpring},
+		},
+		{
+			s:    "Winter 1850/51",
+			alts: []string{"winter 1850", "Winter 1850-51", "Winter 1850/1851"},
+			want: &Season{Y: 1850, S: SeasonWinter},
+		},
+		{
+			s:    "Spring 1850/51",
+			want: &Unknown{Text: "Spring 1850/51"},
+		},
+		{
+			s:    "harvest 1820",
+			want: &Season{Y: 1820, S: SeasonHarvest},
+		},
+		{
+			s:    "Lady Day 1720",
+			alts: []string{"lady day 1720", "Ladyday 1720"},
+			want: &QuarterDay{Y: 1720, Q: 1},
+		},
+		{
+			s:    "Midsummer 1850",
+			alts: []string{"Midsummer Day 1850"},
+			want: &QuarterDay{Y: 1850, Q: 2},
+		},
+		{
+			s:    "Michaelmas 1850",
+			alts: []string{"michaelmas 1850", "Michaelmas, 1850"},
+			want: &QuarterDay{Y: 1850, Q: 3},
+		},
+		{
+			s:    "Easter Monday 1850",
+			alts: []string{"easter monday 1850", "the day after Easter 1850", "the morrow of Easter 1850"},
+			want: &Precise{Y: 1850, M: 4, D: 1},
+		},
+		{
+			s:    "the Feast of St Martin 1450",
+			alts: []string{"St Martin's Day 1450", "Martinmas 1450", "Feast of St. Martin, 1450"},
+			want: &Precise{Y: 1450, M: 11, D: 11},
+		},
+		{
+			s:    "Whitsun 1590",
+			alts: []string{"Whit Sunday 1590", "Pentecost 1590", "the 10th day after Ascension Day 1590"},
+			want: &Precise{Y: 1590, M: 6, D: 10},
+		},
+		{
+			s:    "Tuesday after Michaelmas 1850",
+			alts: []string{"the Tuesday next after Michaelmas 1850", "the Tuesday after 29 Sep 1850", "the second day after Michaelmas 1850"},
+			want: &Precise{Y: 1850, M: 10, D: 1},
+		},
+		{
+			s:    "the Sunday before Michaelmas 1850",
+			alts: []string{"the 7th day before Michaelmas 1850", "7 days before Michaelmas 1850", "the seventh day before Michaelmas 1850"},
+			want: &Precise{Y: 1850, M: 9, D: 22},
+		},
+		{
+			s:    "the eve of All Saints 1450",
+			alts: []string{"the vigil of All Saints 1450"},
+			want: &Precise{Y: 1450, M: 10, D: 31},
+		},
+		{
+			s:    "the octave of Michaelmas 1850",
+			want: &Precise{Y: 1850, M: 10, D: 6},
+		},
+		{
+			s:    "the Feast of St Nobody 1450",
+			want: &Unknown{Text: "the Feast of St Nobody 1450"},
+		},
+		{
+			s:    "Thomas 1851",
+			want: &Unknown{Text: "Thomas 1851"},
+		},
+		{
+			s:    "Anne 1790",
+			want: &Unknown{Text: "Anne 1790"},
+		},
+		{
+			s:    "Christmas 1850",
+			alts: []string{"Christmas Day 1850", "Xmas 1850"},
+			want: &QuarterDay{Y: 1850, Q: 4},
+		},
+		{
+			s:    "5 Mar 18__",
+			alts: []string{"5 Mar 18??", "5 March, 18__"},
+			want: &Partial{Y: 1800, M: 3, D: 5, UnknownYearDigits: 2},
+		},
+		{
+			s:    "?? Mar 1850",
+			alts: []string{"? Mar 1850", "__ March 1850"},
+			want: &Partial{Y: 1850, M: 3},
+		},
+		{
+			s:    "5 ? 1850",
+			alts: []string{"5 ?? 1850", "5 ___ 1850"},
+			want: &Partial{Y: 1850, D: 5},
+		},
+		{
+			s:    "Mar 185?",
+			alts: []string{"March 185_"},
+			want: &Partial{Y: 1850, M: 3, UnknownYearDigits: 1, NoDay: true},
+		},
+		{
+			s:    "29 Feb 18__",
+			want: &Partial{Y: 1800, M: 2, D: 29, UnknownYearDigits: 2},
+		},
+		{
+			s:    "30 Feb 18__",
+			want: &Unknown{Text: "30 Feb 18__"},
+		},
+		{
+			s:    "? ? 1850",
+			want: &Partial{Y: 1850},
+		},
+		{
+			s:    "185?",
+			alts: []string{"185_"},
+			want: &Decade{Y: 1850},
+		},
+		{
+			s:    "18__",
+			alts: []string{"18??"},
+			want: &Century{N: 19},
+		},
+		{
+			s:    "1???",
+			want: &Unknown{Text: "1???"},
+		},
+		{
+			s:    "1850 or 1851",
+			alts: []string{"[1850,1851]", "[1850..1851]"},
+			want: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}},
+		},
+		{
+			s:    "5 or 6 Mar 1850",
+			alts: []string{"5 Mar 1850 or 6 Mar 1850", "March 5, 1850 or March 6, 1850", "[1850-03-05,1850-03-06]"},
+			want: &OneOf{Dates: []Date{&Precise{Y: 1850, M: 3, D: 5}, &Precise{Y: 1850, M: 3, D: 6}}},
+		},
+		{
+			s:    "5, 12 and 19 Mar 1850",
+			alts: []string{"5, 12, and 19 March 1850", "5 Mar 1850, 12 Mar 1850, 19 Mar 1850", "{1850-03-05,1850-03-12,1850-03-19}"},
+			want: &AllOf{Dates: []Date{&Precise{Y: 1850, M: 3, D: 5}, &Precise{Y: 1850, M: 3, D: 12}, &Precise{Y: 1850, M: 3, D: 19}}},
+		},
+		{
+			s:    "Mar or Apr 1850",
+			want: &OneOf{Dates: []Date{&MonthYear{Y: 1850, M: 3}, &MonthYear{Y: 1850, M: 4}}},
+		},
+		{
+			s:    "Spring or Summer 1850",
+			want: &OneOf{Dates: []Date{&Season{Y: 1850, S: SeasonSpring}, &Season{Y: 1850, S: SeasonSummer}}},
+		},
+		{
+			s:    "[1667,1668,1670..1672]",
+			want: &OneOf{Dates: []Date{&Year{Y: 1667}, &Year{Y: 1668}, &Year{Y: 1670}, &Year{Y: 1671}, &Year{Y: 1672}}},
+		},
+		{
+			s:    "bef. Mar 1850",
+			alts: []string{"before March 1850", "BEF MAR 1850"},
+			want: &BeforeMonthYear{Y: 1850, M: 3},
+		},
+		{
+			s:    "aft. Mar 1850",
+			alts: []string{"after March 1850"},
+			want: &AfterMonthYear{Y: 1850, M: 3},
+		},
+		{
+			s:    "after Jun qtr 1850",
+			alts: []string{"aft. Q2 1850", "after the Jun quarter of 1850"},
+			want: &AfterQuarter{Y: 1850, Q: 2},
+		},
+		{
+			s:    "before the Dec quarter 1850",
+			alts: []string{"bef. Dec qtr. 1850", "before Q4 1850"},
+			want: &BeforeQuarter{Y: 1850, Q: 4},
+		},
+		{
+			s:    "bef. 5 Mar 1850",
+			alts: []string{"before March 5th, 1850"},
+			want: &BeforePrecise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "aft. 5 Mar 1850",
+			want: &AfterPrecise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "Sep qtr 1850",
+			alts: []string{"the September quarter of 1850"},
+			want: &YearQuarter{Y: 1850, Q: 3},
+		},
+		{
+			s:    "Aug qtr 1850",
+			want: &Unknown{Text: "Aug qtr 1850"},
+		},
+		{
+			s:    "from 1850 to 1860",
+			alts: []string{"FROM 1850 TO 1860", "from 1850 until 1860"},
+			want: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}},
+		},
+		{
+			s:    "from 5 Mar 1850 to Jun 1860",
+			want: &Period{Start: &Precise{Y: 1850, M: 3, D: 5}, End: &MonthYear{Y: 1860, M: 6}},
+		},
+		{
+			s:    "from 1871",
+			alts: []string{"1871-", "1871 –"},
+			want: &Period{Start: &Year{Y: 1871}},
+		},
+		{
+			s:    "to 1860",
+			alts: []string{"until 1860", "–1860", "- 1860"},
+			want: &Period{End: &Year{Y: 1860}},
+		},
+		{
+			s:    "from abt. 1850",
+			want: &Unknown{Text: "from abt. 1850"},
+		},
+		{
+			s:    "1850 or 1851 and 1852",
+			want: &Unknown{Text: "1850 or 1851 and 1852"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -256,6 +574,16 @@ func TestParseReckoningLocation(t *testing.T) {
 			l:    ReckoningLocationScotland,
 			want: &Precise{Y: 1751, M: 4, D: 2, C: Julian},
 		},
+		{
+			s:    "29 Feb 1699",
+			l:    ReckoningLocationEnglandAndWales,
+			want: &Precise{Y: 1699, M: 2, D: 29, C: Julian25Mar},
+		},
+		{
+			s:    "29 Feb 1700",
+			l:    ReckoningLocationScotland,
+			want: &Precise{Y: 1700, M: 2, D: 29, C: Julian},
+		},
 		{
 			s:    "2 Apr 1558",
 			l:    ReckoningLocationScotland,
@@ -354,3 +682,226 @@ func TestParseReckoningLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTwoDigitYear(t *testing.T) {
+	testCases := []struct {
+		s       string
+		p       Parser
+		want    Date
+		warning bool
+	}{
+		{
+			s:       "5 Mar 52",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &Precise{Y: 1852, M: 3, D: 5},
+			warning: true,
+		},
+		{
+			s:       "Mar '52",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &MonthYear{Y: 1852, M: 3},
+			warning: true,
+		},
+		{
+			s:       "Mar 5, 52",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &Precise{Y: 1852, M: 3, D: 5},
+			warning: true,
+		},
+		{
+			s:       "bef. '99",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &BeforeYear{Y: 1899},
+			warning: true,
+		},
+		{
+			s:       "Mar '00",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &MonthYear{Y: 1800, M: 3},
+			warning: true,
+		},
+		{
+			s:       "5 Mar 52",
+			p:       Parser{TwoDigitYearPivot: 1960},
+			want:    &Precise{Y: 1952, M: 3, D: 5},
+			warning: true,
+		},
+		{
+			// context date overrides the pivot
+			s:       "5 Mar 52",
+			p:       Parser{TwoDigitYearPivot: 1960, ContextDate: &Precise{Y: 1881, M: 4, D: 3}},
+			want:    &Precise{Y: 1852, M: 3, D: 5},
+			warning: true,
+		},
+		{
+			s:       "5 Mar 82",
+			p:       Parser{ContextDate: &Year{Y: 1881}},
+			want:    &Precise{Y: 1782, M: 3, D: 5},
+			warning: true,
+		},
+		{
+			s:       "5 Mar 1852",
+			p:       Parser{TwoDigitYearPivot: 1960},
+			want:    &Precise{Y: 1852, M: 3, D: 5},
+			warning: false,
+		},
+		{
+			// not expanded without a pivot or context
+			s:       "5 Mar 52",
+			p:       Parser{},
+			want:    &Unknown{Text: "5 Mar 52"},
+			warning: false,
+		},
+		{
+			s:       "52",
+			p:       Parser{TwoDigitYearPivot: 1899},
+			want:    &Unknown{Text: "52"},
+			warning: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			dt, warnings, err := tc.p.ParseWithWarnings(tc.s)
+			if err != nil {
+				t.Fatalf("got unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, dt); diff != "" {
+				t.Errorf("Parse(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+
+			if tc.warning {
+				if len(warnings) != 1 || warnings[0].Kind != WarningTwoDigitYear {
+					t.Errorf("got warnings %v, wanted a two digit year warning", warnings)
+				}
+			} else if len(warnings) != 0 {
+				t.Errorf("got unexpected warnings %v", warnings)
+			}
+		})
+	}
+}
+
+func TestParseHemisphere(t *testing.T) {
+	p := &Parser{Hemisphere: HemisphereSouthern}
+	dt, err := p.Parse("Summer 1850/51")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	want := &Season{Y: 1850, S: SeasonSummer, H: HemisphereSouthern}
+	if diff := cmp.Diff(want, dt); diff != "" {
+		t.Errorf("Parse mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	testCases := []struct {
+		s        string
+		p        Parser
+		want     Date
+		warnings []WarningKind
+	}{
+		{
+			s:    "Tuesday 5 March 1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "Tuesday, 5 March 1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "Tue. 5 Mar 1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:    "Tues Mar 5, 1850",
+			want: &Precise{Y: 1850, M: 3, D: 5},
+		},
+		{
+			s:        "Monday 5 March 1850",
+			want:     &Precise{Y: 1850, M: 3, D: 5},
+			warnings: []WarningKind{WarningWeekdayMismatch},
+		},
+		{
+			// 2 Sep 1751 was a Monday in the Julian calendar
+			s:        "Wednesday 2 Sep 1751",
+			p:        Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want:     &Precise{Y: 1751, M: 9, D: 2, C: Julian25Mar},
+			warnings: []WarningKind{WarningWeekdayMismatch},
+		},
+		{
+			s:    "Monday 2 Sep 1751",
+			p:    Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want: &Precise{Y: 1751, M: 9, D: 2, C: Julian25Mar},
+		},
+		{
+			s:        "Tue 5 Mar 52",
+			p:        Parser{TwoDigitYearPivot: 1899},
+			want:     &Precise{Y: 1852, M: 3, D: 5},
+			warnings: []WarningKind{WarningTwoDigitYear, WarningWeekdayMismatch},
+		},
+		{
+			s:    "Tuesday March 1850",
+			want: &Unknown{Text: "Tuesday March 1850"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			dt, warnings, err := tc.p.ParseWithWarnings(tc.s)
+			if err != nil {
+				t.Fatalf("got unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, dt); diff != "" {
+				t.Errorf("Parse(%q) mismatch (-want +got):\n%s", tc.s, diff)
+			}
+
+			var kinds []WarningKind
+			for _, w := range warnings {
+				kinds = append(kinds, w.Kind)
+			}
+			if diff := cmp.Diff(tc.warnings, kinds); diff != "" {
+				t.Errorf("warnings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+var benchmarkInputs = []string{
+	"2 Apr 1871",
+	"Apr 2, 1871",
+	"1871-04-02",
+	"1950",
+	"bef. 1950",
+	"about 1950",
+	"January 1950",
+	"Q1 1950",
+	"1950-05",
+	"1920-1923",
+	"1850s",
+	"Michaelmas 1850",
+	"Easter Monday 1850",
+	"not a date",
+}
+
+func BenchmarkParse(b *testing.B) {
+	p := &Parser{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, s := range benchmarkInputs {
+			if _, err := p.Parse(s); err != nil {
+				b.Fatalf("got unexpected error: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkFindAll(b *testing.B) {
+	text := "baptised 5 March 1850 at St Mary's, married Tuesday 5 March 1872 and buried about 1901 in the parish of Leeds"
+	p := &Parser{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p.FindAll(text)
+	}
+}
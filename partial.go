@@ -0,0 +1,113 @@
+package gdate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Partial represents a date in which some components are unknown, as often found in registers and
+// transcriptions, such as "5 Mar 18__", "?? Mar 1850", "5 ? 1850" or "Mar 185?". Its bounds span
+// every date that the unknown components could take.
+type Partial struct {
+	C                 Calendar
+	Y                 int  // year with any unknown digits set to zero, such as 1800 for 18__
+	M                 int  // month, or 0 if the month is unknown
+	D                 int  // day, or 0 if the day is unknown or not given
+	UnknownYearDigits int  // number of unknown trailing digits of the year
+	NoDay             bool // the date has no day, as in "Mar 185?"
+}
+
+func (p *Partial) String() string {
+	var b strings.Builder
+	if !p.NoDay {
+		if p.D == 0 {
+			b.WriteString("? ")
+		} else {
+			fmt.Fprintf(&b, "%d ", p.D)
+		}
+	}
+	if p.M == 0 {
+		b.WriteString("? ")
+	} else {
+		b.WriteString(shortMonthNames[p.M] + " ")
+	}
+	b.WriteString(p.yearString())
+	return b.String()
+}
+
+// yearString formats the year with a question mark in place of each unknown digit
+func (p *Partial) yearString() string {
+	k := p.UnknownYearDigits
+	if k == 0 {
+		return fmt.Sprintf("%04d", p.Y)
+	}
+	return fmt.Sprintf("%0*d", 4-k, p.Y/pow10(k)) + strings.Repeat("?", k)
+}
+
+func (p *Partial) Occurrence() string {
+	if p.NoDay {
+		return "in " + p.String()
+	}
+	return "on " + p.String()
+}
+
+func (p *Partial) Calendar() Calendar {
+	return p.C
+}
+
+// years returns the first and last years that the year could be
+func (p *Partial) years() (int, int) {
+	return p.Y, p.Y + pow10(p.UnknownYearDigits) - 1
+}
+
+func (p *Partial) EarliestJulianDay() int {
+	y, _ := p.years()
+	switch {
+	case p.M == 0 && p.D == 0:
+		return yearStart(p.C, y)
+	case p.D == 0:
+		lo, _ := monthSpan(p.C, y, p.M, p.M)
+		return lo
+	}
+	lo, _ := p.daySpan(y)
+	return lo
+}
+
+func (p *Partial) LatestJulianDay() int {
+	_, y := p.years()
+	switch {
+	case p.M == 0 && p.D == 0:
+		return yearEnd(p.C, y)
+	case p.D == 0:
+		_, hi := monthSpan(p.C, y, p.M, p.M)
+		return hi
+	}
+	_, hi := p.daySpan(y)
+	return hi
+}
+
+// daySpan returns the first and last Julian days in year y on which the known day could fall, which is
+// the day of each month of the year if the month is unknown. A known day such as 29 Feb may not exist in
+// the year, so is limited to the month.
+func (p *Partial) daySpan(y int) (int, int) {
+	first, last := p.M, p.M
+	if p.M == 0 {
+		first, last = 1, 12
+	}
+	lo, hi := math.MaxInt, math.MinInt
+	for m := first; m <= last; m++ {
+		jd := p.C.JulianDay(y, m, min(p.D, p.C.DaysInMonth(y, m)))
+		lo, hi = min(lo, jd), max(hi, jd)
+	}
+	return lo, hi
+}
+
+// pow10 returns 10 raised to the power n
+func pow10(n int) int {
+	v := 1
+	for ; n > 0; n-- {
+		v *= 10
+	}
+	return v
+}
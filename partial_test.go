@@ -0,0 +1,114 @@
+package gdate
+
+import (
+	"testing"
+)
+
+func TestPartialString(t *testing.T) {
+	testCases := []struct {
+		d          Date
+		str        string
+		occurrence string
+	}{
+		{
+			d:          &Partial{Y: 1800, M: 3, D: 5, UnknownYearDigits: 2},
+			str:        "5 Mar 18??",
+			occurrence: "on 5 Mar 18??",
+		},
+		{
+			d:          &Partial{Y: 1850, M: 3},
+			str:        "? Mar 1850",
+			occurrence: "on ? Mar 1850",
+		},
+		{
+			d:          &Partial{Y: 1850, D: 5},
+			str:        "5 ? 1850",
+			occurrence: "on 5 ? 1850",
+		},
+		{
+			d:          &Partial{Y: 1850, M: 3, UnknownYearDigits: 1, NoDay: true},
+			str:        "Mar 185?",
+			occurrence: "in Mar 185?",
+		},
+		{
+			d:          &Partial{Y: 800, M: 6, D: 1, UnknownYearDigits: 1},
+			str:        "1 Jun 080?",
+			occurrence: "on 1 Jun 080?",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.str, func(t *testing.T) {
+			if got := tc.d.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+			if got := tc.d.Occurrence(); got != tc.occurrence {
+				t.Errorf("got Occurrence()=%q, want %q", got, tc.occurrence)
+			}
+		})
+	}
+}
+
+func TestPartialBounds(t *testing.T) {
+	testCases := []struct {
+		d        ComparableDate
+		earliest *Precise
+		latest   *Precise
+	}{
+		{
+			d:        &Partial{Y: 1800, M: 3, D: 5, UnknownYearDigits: 2},
+			earliest: &Precise{Y: 1800, M: 3, D: 5},
+			latest:   &Precise{Y: 1899, M: 3, D: 5},
+		},
+		{
+			d:        &Partial{Y: 1850, M: 3},
+			earliest: &Precise{Y: 1850, M: 3, D: 1},
+			latest:   &Precise{Y: 1850, M: 3, D: 31},
+		},
+		{
+			d:        &Partial{Y: 1850, D: 5},
+			earliest: &Precise{Y: 1850, M: 1, D: 5},
+			latest:   &Precise{Y: 1850, M: 12, D: 5},
+		},
+		{
+			d:        &Partial{Y: 1850, M: 2, UnknownYearDigits: 1, NoDay: true},
+			earliest: &Precise{Y: 1850, M: 2, D: 1},
+			latest:   &Precise{Y: 1859, M: 2, D: 28},
+		},
+		{
+			d:        &Partial{Y: 1850, D: 31, UnknownYearDigits: 1},
+			earliest: &Precise{Y: 1850, M: 1, D: 31},
+			latest:   &Precise{Y: 1859, M: 12, D: 31},
+		},
+		{
+			// 29 Feb does not occur in 1800, the earliest year
+			d:        &Partial{Y: 1800, M: 2, D: 29, UnknownYearDigits: 2},
+			earliest: &Precise{Y: 1800, M: 2, D: 28},
+			latest:   &Precise{Y: 1899, M: 2, D: 28},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.(Date).String(), func(t *testing.T) {
+			if got, want := tc.d.EarliestJulianDay(), tc.earliest.EarliestJulianDay(); got != want {
+				t.Errorf("got earliest %d, want %d (%s)", got, want, tc.earliest)
+			}
+			if got, want := tc.d.LatestJulianDay(), tc.latest.LatestJulianDay(); got != want {
+				t.Errorf("got latest %d, want %d (%s)", got, want, tc.latest)
+			}
+		})
+	}
+}
+
+func TestPartialSortsBefore(t *testing.T) {
+	a := &Partial{Y: 1850, M: 3}
+	if !SortsBefore(&Precise{Y: 1850, M: 2, D: 28}, a) {
+		t.Errorf("28 Feb 1850 should sort before %s", a)
+	}
+	if !SortsBefore(a, &Precise{Y: 1850, M: 3, D: 1}) {
+		t.Errorf("%s should sort before 1 Mar 1850, which it contains", a)
+	}
+	if !SortsBefore(a, &Precise{Y: 1850, M: 4, D: 1}) {
+		t.Errorf("%s should sort before 1 Apr 1850", a)
+	}
+}
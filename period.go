@@ -0,0 +1,120 @@
+package gdate
+
+import "math"
+
+// Period represents a span of time during which something continued, such as a residence "from 1850 to 1860"
+// or an occupation "from 1871". Unlike a range such as YearRange, which holds a single event at some unknown
+// point within it, a period lasts from its start to its end. Either end may be open, in which case it is nil.
+// Start and End are dates with bounds, such as a Year or a Precise date.
+type Period struct {
+	Start Date // the date on which the period began, or nil if the start is open
+	End   Date // the date on which the period ended, or nil if the end is open
+}
+
+func (p *Period) String() string {
+	switch {
+	case p.Start == nil && p.End == nil:
+		return "unknown period"
+	case p.Start == nil:
+		return "to " + p.End.String()
+	case p.End == nil:
+		return "from " + p.Start.String()
+	}
+	return "from " + p.Start.String() + " to " + p.End.String()
+}
+
+func (p *Period) Occurrence() string {
+	return p.String()
+}
+
+func (p *Period) Calendar() Calendar {
+	if p.Start != nil {
+		return p.Start.Calendar()
+	}
+	if p.End != nil {
+		return p.End.Calendar()
+	}
+	return Gregorian
+}
+
+// sortKey returns the key by which the period sorts. A period sorts as a date with bounds from its start to
+// its end, so it sorts before dates that start on the same day and end before it. A period with an open start
+// sorts as though it were before its end.
+func (p *Period) sortKey() sortKey {
+	if p.Start == nil {
+		if cd, ok := p.End.(ComparableDate); ok && cd.EarliestJulianDay() != math.MinInt {
+			return sortKey{day: cd.EarliestJulianDay(), phase: phaseBefore}
+		}
+		return sortKey{phase: phaseUnknown}
+	}
+	cd, ok := p.Start.(ComparableDate)
+	if !ok || cd.EarliestJulianDay() == math.MinInt {
+		return sortKey{phase: phaseUnknown}
+	}
+	latest := math.MaxInt
+	if ce, ok := p.End.(ComparableDate); ok {
+		latest = ce.LatestJulianDay()
+	}
+	return sortKey{day: cd.EarliestJulianDay(), phase: phaseBounded, span: -latest}
+}
+
+func (p *Period) SortsBefore(d Date) bool {
+	return SortsBefore(p, d)
+}
+
+// period parses periods such as "from 1850 to 1860", "from 1871", "to 1860", "until 5 Mar 1860", "1850–"
+// and "–1860".
+func (g *grammar) period() Date {
+	n := len(g.toks)
+	if g.item || n < 2 {
+		return nil
+	}
+	st := newParseState()
+	var start, end Date
+	switch {
+	case g.at(0, "from"):
+		to := 1
+		for to < n && !g.periodEnd(to) {
+			to++
+		}
+		if start = g.bounded(st, g.s, g.toks[1:to]); start == nil {
+			return nil
+		}
+		if to < n {
+			if end = g.bounded(st, g.s, g.toks[to+1:]); end == nil {
+				return nil
+			}
+		}
+	case g.periodEnd(0):
+		if end = g.bounded(st, g.s, g.toks[1:]); end == nil {
+			return nil
+		}
+	case g.dash(n - 1):
+		if start = g.bounded(st, g.s, g.toks[:n-1]); start == nil {
+			return nil
+		}
+	case g.dash(0):
+		if end = g.bounded(st, g.s, g.toks[1:]); end == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	g.keep(st, RulePeriod, func(part, alt Date) Date {
+		if part == start {
+			return &Period{Start: alt, End: end}
+		}
+		return &Period{Start: start, End: alt}
+	})
+	return &Period{Start: start, End: end}
+}
+
+// periodEnd reports whether token i introduces the end of a period, as "to" and "until" do
+func (g *grammar) periodEnd(i int) bool {
+	return g.at(i, "to") || g.at(i, "until") || g.at(i, "till")
+}
+
+// dash reports whether token i is a hyphen or dash
+func (g *grammar) dash(i int) bool {
+	return g.at(i, "-") || g.at(i, "–") || g.at(i, "—")
+}
@@ -0,0 +1,36 @@
+package gdate
+
+import (
+	"testing"
+)
+
+func TestPeriodString(t *testing.T) {
+	testCases := []struct {
+		d   Date
+		str string
+	}{
+		{
+			d:   &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}},
+			str: "from 1850 to 1860",
+		},
+		{
+			d:   &Period{Start: &Precise{Y: 1871, M: 4, D: 2}},
+			str: "from 2 Apr 1871",
+		},
+		{
+			d:   &Period{End: &MonthYear{Y: 1860, M: 6}},
+			str: "to Jun 1860",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.str, func(t *testing.T) {
+			if got := tc.d.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+			if got := tc.d.Occurrence(); got != tc.str {
+				t.Errorf("got Occurrence()=%q, want %q", got, tc.str)
+			}
+		})
+	}
+}
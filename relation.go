@@ -0,0 +1,310 @@
+package gdate
+
+import (
+	"math"
+	"strconv"
+)
+
+// Certainty describes how certain it is that a relationship between two dates holds, given that each date
+// may fall on any day between its earliest and latest possible day.
+type Certainty int
+
+const (
+	Never      Certainty = 0 // the relationship cannot hold
+	Possibly   Certainty = 1 // the relationship holds for some of the days the dates could fall on
+	Definitely Certainty = 2 // the relationship holds whichever days the dates fall on
+)
+
+func (c Certainty) String() string {
+	switch c {
+	case Never:
+		return "never"
+	case Possibly:
+		return "possibly"
+	case Definitely:
+		return "definitely"
+	default:
+		return "unknown certainty (" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+// Relation is one of Allen's interval relations between the days on which two dates could fall, such as
+// the relation "during" between 5 Mar 1850 and 1850.
+type Relation int
+
+const (
+	RelationUnknown      Relation = 0  // the relation cannot be determined because a date has no bounds
+	RelationBefore       Relation = 1  // the first date ends before the second starts, with a gap between them
+	RelationMeets        Relation = 2  // the first date ends on the day before the second starts
+	RelationOverlaps     Relation = 3  // the first date starts first and ends during the second
+	RelationFinishedBy   Relation = 4  // the first date starts first and both end on the same day
+	RelationContains     Relation = 5  // the first date starts before and ends after the second
+	RelationStarts       Relation = 6  // both dates start on the same day and the first ends first
+	RelationEquals       Relation = 7  // both dates start and end on the same days
+	RelationStartedBy    Relation = 8  // both dates start on the same day and the second ends first
+	RelationDuring       Relation = 9  // the first date starts after and ends before the second
+	RelationFinishes     Relation = 10 // the second date starts first and both end on the same day
+	RelationOverlappedBy Relation = 11 // the second date starts first and ends during the first
+	RelationMetBy        Relation = 12 // the second date ends on the day before the first starts
+	RelationAfter        Relation = 13 // the second date ends before the first starts, with a gap between them
+)
+
+var relationNames = []string{
+	RelationUnknown:      "unknown",
+	RelationBefore:       "before",
+	RelationMeets:        "meets",
+	RelationOverlaps:     "overlaps",
+	RelationFinishedBy:   "finished by",
+	RelationContains:     "contains",
+	RelationStarts:       "starts",
+	RelationEquals:       "equals",
+	RelationStartedBy:    "started by",
+	RelationDuring:       "during",
+	RelationFinishes:     "finishes",
+	RelationOverlappedBy: "overlapped by",
+	RelationMetBy:        "met by",
+	RelationAfter:        "after",
+}
+
+func (r Relation) String() string {
+	if r < 0 || int(r) >= len(relationNames) {
+		return "unknown relation (" + strconv.Itoa(int(r)) + ")"
+	}
+	return relationNames[r]
+}
+
+// Relate returns the Allen relation between the ranges of days on which a and b could fall. Dates that are
+// open ended, such as "bef. 1850" or "aft. 5 Mar 1850", have no earliest or latest day. RelationUnknown is
+// returned if either date has neither an earliest nor a latest day, as for Unknown or AboutYear. Use the
+// Relate method of a BoundsPolicy to give open ended and approximate dates bounds.
+func Relate(a, b Date) Relation {
+	return OpenBounds.Relate(a, b)
+}
+
+// IsBefore reports whether a is definitely, possibly or never before b. It is definitely before if the latest
+// day a could fall on is before the earliest day of b, and never before if a cannot fall on a day before
+// the latest day of b. Open ended and approximate dates have no bounds.
+func IsBefore(a, b Date) Certainty {
+	return OpenBounds.IsBefore(a, b)
+}
+
+// IsAfter reports whether a is definitely, possibly or never after b. Open ended and approximate dates have
+// no bounds.
+func IsAfter(a, b Date) Certainty {
+	return OpenBounds.IsBefore(b, a)
+}
+
+// Relate returns the Allen relation between the ranges of days on which a and b could fall, using the
+// bounds given to each date by the policy. RelationUnknown is returned if either date has neither an
+// earliest nor a latest day.
+func (p BoundsPolicy) Relate(a, b Date) Relation {
+	a1, a2 := p.Bounds(a)
+	b1, b2 := p.Bounds(b)
+	if (a1 == math.MinInt && a2 == math.MaxInt) || (b1 == math.MinInt && b2 == math.MaxInt) {
+		return RelationUnknown
+	}
+	switch {
+	case a2 < b1:
+		if a2+1 == b1 {
+			return RelationMeets
+		}
+		return RelationBefore
+	case b2 < a1:
+		if b2+1 == a1 {
+			return RelationMetBy
+		}
+		return RelationAfter
+	case a1 == b1 && a2 == b2:
+		return RelationEquals
+	case a1 == b1 && a2 < b2:
+		return RelationStarts
+	case a1 == b1:
+		return RelationStartedBy
+	case a2 == b2 && a1 > b1:
+		return RelationFinishes
+	case a2 == b2:
+		return RelationFinishedBy
+	case a1 > b1 && a2 < b2:
+		return RelationDuring
+	case a1 < b1 && a2 > b2:
+		return RelationContains
+	case a1 < b1:
+		return RelationOverlaps
+	}
+	return RelationOverlappedBy
+}
+
+// IsBefore reports whether a is definitely, possibly or never before b using the bounds given to each date
+// by the policy.
+func (p BoundsPolicy) IsBefore(a, b Date) Certainty {
+	a1, a2 := p.Bounds(a)
+	b1, b2 := p.Bounds(b)
+	switch {
+	case a2 < b1:
+		return Definitely
+	case a1 >= b2:
+		return Never
+	}
+	return Possibly
+}
+
+// IsAfter reports whether a is definitely, possibly or never after b using the bounds given to each date
+// by the policy.
+func (p BoundsPolicy) IsAfter(a, b Date) Certainty {
+	return p.IsBefore(b, a)
+}
+
+// Contains reports whether b definitely, possibly or never falls within a, such as whether a record dated
+// b falls within a lifespan a. Open ended and approximate dates have no bounds.
+func Contains(a, b Date) Certainty {
+	return OpenBounds.Contains(a, b)
+}
+
+// Overlaps reports whether the days covered by a and b definitely, possibly or never overlap. Open ended and
+// approximate dates have no bounds.
+func Overlaps(a, b Date) Certainty {
+	return OpenBounds.Overlaps(a, b)
+}
+
+// Contains reports whether b definitely, possibly or never falls within a using the bounds given to each
+// date by the policy. A whole day, month, quarter, year, decade or century covers every day within it, so
+// 5 Mar 1850 definitely falls within 1850. A Period covers the days from its start to its end, but since its
+// start and end may themselves be uncertain, b definitely falls within it only if b is after the latest day
+// the period could start and before the earliest day it could end. A period with an open end continues
+// indefinitely. Any other date, such as "abt. 1850", "bef. 1850" or 1850-1852, falls on a single unknown
+// day within its bounds, so nothing definitely falls within it. The dates in a OneOf or AllOf are
+// considered one by one, so 1855 never falls within "1850 or 1860".
+func (p BoundsPolicy) Contains(a, b Date) Certainty {
+	switch ta := a.(type) {
+	case *OneOf:
+		return eachOf(ta.Dates, func(d Date) Certainty { return p.Contains(d, b) })
+	case *AllOf:
+		return anyOf(ta.Dates, func(d Date) Certainty { return p.Contains(d, b) })
+	}
+	switch tb := b.(type) {
+	case *OneOf:
+		return eachOf(tb.Dates, func(d Date) Certainty { return p.Contains(a, d) })
+	case *AllOf:
+		return allOf(tb.Dates, func(d Date) Certainty { return p.Contains(a, d) })
+	}
+
+	a1, a2, core1, core2, ok := p.span(a)
+	b1, b2 := p.Bounds(b)
+	switch {
+	case !ok || (b1 == math.MinInt && b2 == math.MaxInt):
+		return Possibly
+	case b2 < a1 || b1 > a2:
+		return Never
+	case core1 <= b1 && b2 <= core2:
+		return Definitely
+	}
+	return Possibly
+}
+
+// Overlaps reports whether the days covered by a and b definitely, possibly or never overlap using the bounds
+// given to each date by the policy. As with Contains, only whole days, months, quarters, years, decades and
+// centuries, and the days a Period certainly lasts, definitely cover their days. Two dates definitely overlap
+// if the days they certainly cover overlap or one of them certainly covers every day the other could fall
+// on. The dates in a OneOf or AllOf are considered one by one.
+func (p BoundsPolicy) Overlaps(a, b Date) Certainty {
+	switch ta := a.(type) {
+	case *OneOf:
+		return eachOf(ta.Dates, func(d Date) Certainty { return p.Overlaps(d, b) })
+	case *AllOf:
+		return anyOf(ta.Dates, func(d Date) Certainty { return p.Overlaps(d, b) })
+	}
+	switch tb := b.(type) {
+	case *OneOf:
+		return eachOf(tb.Dates, func(d Date) Certainty { return p.Overlaps(a, d) })
+	case *AllOf:
+		return anyOf(tb.Dates, func(d Date) Certainty { return p.Overlaps(a, d) })
+	}
+
+	a1, a2, acore1, acore2, aok := p.span(a)
+	b1, b2, bcore1, bcore2, bok := p.span(b)
+	switch {
+	case !aok || !bok:
+		return Possibly
+	case a2 < b1 || b2 < a1:
+		return Never
+	case max(acore1, bcore1) <= min(acore2, bcore2),
+		acore1 <= b1 && b2 <= acore2,
+		bcore1 <= a1 && a2 <= bcore2:
+		return Definitely
+	}
+	return Possibly
+}
+
+// span returns the earliest and latest days that d could cover and the range of days that it certainly
+// covers, which is empty when d may fall on any one of its days. It returns false if d has neither an
+// earliest nor a latest day.
+func (p BoundsPolicy) span(d Date) (int, int, int, int, bool) {
+	if pd, ok := d.(*Period); ok {
+		lo, core1, core2, hi := math.MinInt, math.MinInt, math.MaxInt, math.MaxInt
+		if pd.Start != nil {
+			lo, core1 = p.Bounds(pd.Start)
+		}
+		if pd.End != nil {
+			core2, hi = p.Bounds(pd.End)
+		}
+		return lo, hi, core1, core2, pd.Start != nil || pd.End != nil
+	}
+	lo, hi := p.Bounds(d)
+	ok := lo != math.MinInt || hi != math.MaxInt
+	if _, cd := d.(ComparableDate); cd {
+		switch Normalize(d).(type) {
+		case *Precise, *MonthYear, *YearQuarter, *Year, *Decade, *Century:
+			return lo, hi, lo, hi, ok
+		}
+	}
+	return lo, hi, math.MaxInt, math.MinInt, ok
+}
+
+// eachOf combines the certainties for a date that is one of dates: definite or never only if it is so
+// for every date
+func eachOf(dates []Date, f func(Date) Certainty) Certainty {
+	all, none := true, true
+	for _, d := range dates {
+		switch f(d) {
+		case Definitely:
+			none = false
+		case Never:
+			all = false
+		default:
+			all, none = false, false
+		}
+	}
+	switch {
+	case len(dates) == 0:
+		return Possibly
+	case all:
+		return Definitely
+	case none:
+		return Never
+	}
+	return Possibly
+}
+
+// anyOf combines the certainties for a relationship that holds if it holds for any of dates
+func anyOf(dates []Date, f func(Date) Certainty) Certainty {
+	if len(dates) == 0 {
+		return Possibly
+	}
+	c := Never
+	for _, d := range dates {
+		c = max(c, f(d))
+	}
+	return c
+}
+
+// allOf combines the certainties for a relationship that holds only if it holds for every one of dates
+func allOf(dates []Date, f func(Date) Certainty) Certainty {
+	if len(dates) == 0 {
+		return Possibly
+	}
+	c := Definitely
+	for _, d := range dates {
+		c = min(c, f(d))
+	}
+	return c
+}
@@ -0,0 +1,238 @@
+package gdate
+
+import (
+	"testing"
+)
+
+func TestRelate(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want Relation
+	}{
+		{a: &Year{Y: 1848}, b: &Year{Y: 1850}, want: RelationBefore},
+		{a: &Year{Y: 1849}, b: &Year{Y: 1850}, want: RelationMeets},
+		{a: &YearRange{Lower: 1849, Upper: 1850}, b: &YearRange{Lower: 1850, Upper: 1851}, want: RelationOverlaps},
+		{a: &YearRange{Lower: 1849, Upper: 1850}, b: &Year{Y: 1850}, want: RelationFinishedBy},
+		{a: &Year{Y: 1850}, b: &MonthYear{Y: 1850, M: 6}, want: RelationContains},
+		{a: &YearQuarter{Y: 1850, Q: 1}, b: &Year{Y: 1850}, want: RelationStarts},
+		{a: &Year{Y: 1850}, b: &YearRange{Lower: 1850, Upper: 1850}, want: RelationEquals},
+		{a: &Year{Y: 1850}, b: &MonthYear{Y: 1850, M: 1}, want: RelationStartedBy},
+		{a: &Precise{Y: 1850, M: 3, D: 5}, b: &Year{Y: 1850}, want: RelationDuring},
+		{a: &MonthYear{Y: 1850, M: 12}, b: &Year{Y: 1850}, want: RelationFinishes},
+		{a: &YearRange{Lower: 1850, Upper: 1851}, b: &YearRange{Lower: 1849, Upper: 1850}, want: RelationOverlappedBy},
+		{a: &Year{Y: 1851}, b: &Year{Y: 1850}, want: RelationMetBy},
+		{a: &Year{Y: 1852}, b: &Year{Y: 1850}, want: RelationAfter},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1850}, want: RelationMeets},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1849}, want: RelationFinishedBy},
+		{a: &AfterPrecise{Y: 1850, M: 3, D: 5}, b: &Precise{Y: 1850, M: 3, D: 5}, want: RelationMetBy},
+		{a: &AfterYear{Y: 1849}, b: &Year{Y: 1850}, want: RelationStartedBy},
+		{a: &Period{Start: &Year{Y: 1850}}, b: &Precise{Y: 1860, M: 1, D: 1}, want: RelationContains},
+		{a: &AboutYear{Y: 1850}, b: &Year{Y: 1850}, want: RelationUnknown},
+		{a: &Year{Y: 1850}, b: &Unknown{Text: "?"}, want: RelationUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if got := Relate(tc.a, tc.b); got != tc.want {
+				t.Errorf("got Relate(%q,%q)=%s, want %s", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBefore(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want Certainty
+	}{
+		{a: &Precise{Y: 1850, M: 3, D: 5}, b: &Precise{Y: 1850, M: 3, D: 6}, want: Definitely},
+		{a: &Precise{Y: 1850, M: 3, D: 5}, b: &Precise{Y: 1850, M: 3, D: 5}, want: Never},
+		{a: &Precise{Y: 1850, M: 3, D: 5}, b: &Year{Y: 1850}, want: Possibly},
+		{a: &Year{Y: 1850}, b: &Precise{Y: 1850, M: 1, D: 1}, want: Never},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1850}, want: Definitely},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1849}, want: Possibly},
+		{a: &AfterPrecise{Y: 1850, M: 3, D: 5}, b: &Year{Y: 1850}, want: Possibly},
+		{a: &AfterYear{Y: 1850}, b: &Year{Y: 1850}, want: Never},
+		{a: &AboutYear{Y: 1850}, b: &Year{Y: 1850}, want: Possibly},
+		{a: &Unknown{}, b: &Year{Y: 1850}, want: Possibly},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if got := IsBefore(tc.a, tc.b); got != tc.want {
+				t.Errorf("got IsBefore(%q,%q)=%s, want %s", tc.a, tc.b, got, tc.want)
+			}
+			if got := IsAfter(tc.b, tc.a); got != tc.want {
+				t.Errorf("got IsAfter(%q,%q)=%s, want %s", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	lifespan := &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1910}}
+	testCases := []struct {
+		a, b Date
+		want Certainty
+	}{
+		{a: &Year{Y: 1850}, b: &Precise{Y: 1850, M: 3, D: 5}, want: Definitely},
+		{a: &Year{Y: 1850}, b: &YearRange{Lower: 1850, Upper: 1851}, want: Possibly},
+		{a: &Year{Y: 1850}, b: &Year{Y: 1851}, want: Never},
+		{a: lifespan, b: &Precise{Y: 1881, M: 4, D: 3}, want: Definitely},
+		{a: lifespan, b: &Precise{Y: 1850, M: 3, D: 5}, want: Possibly},
+		{a: lifespan, b: &MonthYear{Y: 1910, M: 6}, want: Possibly},
+		{a: lifespan, b: &Year{Y: 1911}, want: Never},
+		{a: lifespan, b: &BeforeYear{Y: 1860}, want: Possibly},
+		{a: &Period{Start: &Year{Y: 1871}}, b: &Year{Y: 1900}, want: Definitely},
+		{a: &Period{Start: &Year{Y: 1871}}, b: &AfterYear{Y: 1880}, want: Definitely},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1849}, want: Possibly},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1850}, want: Never},
+		{a: &YearRange{Lower: 1850, Upper: 1852}, b: &Year{Y: 1851}, want: Possibly},
+		{a: &YearRange{Lower: 1850, Upper: 1850}, b: &Precise{Y: 1850, M: 3, D: 5}, want: Definitely},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &Year{Y: 1855}, want: Never},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &MonthYear{Y: 1850, M: 3}, want: Possibly},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &YearRange{Lower: 1850, Upper: 1851}}}, b: &Year{Y: 1852}, want: Never},
+		{a: &AllOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &MonthYear{Y: 1860, M: 3}, want: Definitely},
+		{a: lifespan, b: &OneOf{Dates: []Date{&Year{Y: 1860}, &Year{Y: 1870}}}, want: Definitely},
+		{a: lifespan, b: &AllOf{Dates: []Date{&Year{Y: 1860}, &Year{Y: 1920}}}, want: Never},
+		{a: &Unknown{}, b: &Year{Y: 1850}, want: Possibly},
+		{a: lifespan, b: &AboutYear{Y: 1880}, want: Possibly},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if got := Contains(tc.a, tc.b); got != tc.want {
+				t.Errorf("got Contains(%q,%q)=%s, want %s", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+
+	if got := DefaultBounds.Contains(lifespan, &AboutYear{Y: 1880}); got != Definitely {
+		t.Errorf("got DefaultBounds.Contains=%s, want %s", got, Definitely)
+	}
+	if got := DefaultBounds.Contains(&AboutYear{Y: 1850}, &Year{Y: 1850}); got != Possibly {
+		t.Errorf("got DefaultBounds.Contains(abt. 1850, 1850)=%s, want %s", got, Possibly)
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want Certainty
+	}{
+		{a: &YearRange{Lower: 1850, Upper: 1855}, b: &YearRange{Lower: 1853, Upper: 1860}, want: Possibly},
+		{a: &Year{Y: 1850}, b: &YearQuarter{Y: 1850, Q: 2}, want: Definitely},
+		{a: &Decade{Y: 1850}, b: &YearRange{Lower: 1852, Upper: 1854}, want: Definitely},
+		{a: &Decade{Y: 1850}, b: &YearRange{Lower: 1858, Upper: 1862}, want: Possibly},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &Year{Y: 1855}, want: Never},
+		{a: &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &Decade{Y: 1850}, want: Possibly},
+		{a: &AllOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1860}}}, b: &Year{Y: 1860}, want: Definitely},
+		{a: &YearRange{Lower: 1850, Upper: 1855}, b: &YearRange{Lower: 1856, Upper: 1860}, want: Never},
+		{a: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}}, b: &Year{Y: 1850}, want: Definitely},
+		{a: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}}, b: &Period{Start: &Year{Y: 1860}, End: &Year{Y: 1870}}, want: Possibly},
+		{a: &Period{Start: &Year{Y: 1850}, End: &Year{Y: 1860}}, b: &Period{Start: &Year{Y: 1861}}, want: Never},
+		{a: &Period{End: &Year{Y: 1860}}, b: &BeforeYear{Y: 1800}, want: Definitely},
+		{a: &Year{Y: 1850}, b: &Unknown{}, want: Possibly},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if got := Overlaps(tc.a, tc.b); got != tc.want {
+				t.Errorf("got Overlaps(%q,%q)=%s, want %s", tc.a, tc.b, got, tc.want)
+			}
+			if got := Overlaps(tc.b, tc.a); got != tc.want {
+				t.Errorf("got Overlaps(%q,%q)=%s, want %s", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOverlapsBoundsPolicy(t *testing.T) {
+	testCases := []struct {
+		a, b Date
+		want Certainty
+	}{
+		{a: &AboutYear{Y: 1850}, b: &Year{Y: 1852}, want: Possibly},
+		{a: &AboutYear{Y: 1850}, b: &Year{Y: 1853}, want: Never},
+		{a: &AboutYear{Y: 1850}, b: &YearRange{Lower: 1840, Upper: 1860}, want: Possibly},
+		{a: &AboutYear{Y: 1850}, b: &Century{N: 19}, want: Definitely},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1845}, want: Possibly},
+		{a: &BeforeYear{Y: 1850}, b: &Year{Y: 1850}, want: Never},
+		{a: &AfterYear{Y: 1850}, b: &Period{Start: &Year{Y: 1840}}, want: Definitely},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a.String()+" "+tc.b.String(), func(t *testing.T) {
+			if got := DefaultBounds.Overlaps(tc.a, tc.b); got != tc.want {
+				t.Errorf("got Overlaps(%q,%q)=%s, want %s", tc.a, tc.b, got, tc.want)
+			}
+			if got := DefaultBounds.Overlaps(tc.b, tc.a); got != tc.want {
+				t.Errorf("got Overlaps(%q,%q)=%s, want %s", tc.b, tc.a, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRelationsJulian25Mar(t *testing.T) {
+	p := &Parser{ReckoningLocation: ReckoningLocationEnglandAndWales}
+	parse := func(s string) Date {
+		d, err := p.Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) got unexpected error: %v", s, err)
+		}
+		if d.Calendar() != Julian25Mar {
+			t.Fatalf("Parse(%q) got calendar %s, want %s", s, d.Calendar(), Julian25Mar)
+		}
+		return d
+	}
+
+	for _, s := range []string{"1700", "Mar 1700", "Jan 1700", "Jul 1700", "Spring 1700", "Winter 1700", "early 1700", "1700s", "10 Feb 1700"} {
+		t.Run(s, func(t *testing.T) {
+			d := parse(s)
+			if got := Relate(d, d); got != RelationEquals {
+				t.Errorf("got Relate(%q,%q)=%s, want %s", d, d, got, RelationEquals)
+			}
+			if got := IsBefore(d, d); got == Definitely {
+				t.Errorf("got IsBefore(%q,%q)=%s", d, d, got)
+			}
+			if got := Overlaps(d, d); got == Never {
+				t.Errorf("got Overlaps(%q,%q)=%s", d, d, got)
+			}
+			id, ok := Intersect(d, d)
+			if !ok {
+				t.Fatalf("got Intersect(%q,%q) contradiction", d, d)
+			}
+			if !Equal(id, d) {
+				t.Errorf("got Intersect(%q,%q)=%q, want %q", d, d, id, d)
+			}
+		})
+	}
+
+	testCases := []struct {
+		a, b string
+		want Certainty
+	}{
+		{a: "1700", b: "5 Jun 1700", want: Definitely},
+		{a: "1700", b: "10 Feb 1700", want: Definitely},
+		{a: "1700", b: "10 Feb 1701", want: Never},
+		{a: "1700", b: "Mar 1700", want: Definitely},
+		{a: "Jul 1700", b: "Jul 1700", want: Definitely},
+		{a: "1700s", b: "20 Mar 1709", want: Definitely},
+	}
+	for _, tc := range testCases {
+		a, b := parse(tc.a), parse(tc.b)
+		if got := Contains(a, b); got != tc.want {
+			t.Errorf("got Contains(%q,%q)=%s, want %s", a, b, got, tc.want)
+		}
+	}
+
+	if got := IsBefore(parse("10 Feb 1700"), parse("5 Jun 1700")); got != Never {
+		t.Errorf("got IsBefore(10 Feb 1700, 5 Jun 1700)=%s, want %s", got, Never)
+	}
+	if got := Relate(parse("1700"), parse("1701")); got != RelationMeets {
+		t.Errorf("got Relate(1700, 1701)=%s, want %s", got, RelationMeets)
+	}
+	if got := Relate(parse("bef. 1700"), parse("1700")); got != RelationMeets {
+		t.Errorf("got Relate(bef. 1700, 1700)=%s, want %s", got, RelationMeets)
+	}
+}
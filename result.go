@@ -0,0 +1,136 @@
+package gdate
+
+import (
+	"sort"
+)
+
+// A Rule names the form of date that the parser recognised.
+type Rule string
+
+const (
+	RuleUnknown     Rule = ""             // no form of date was recognised
+	RulePrecise     Rule = "precise"      // a day, month and year such as "5 Mar 1850"
+	RuleYear        Rule = "year"         // a year such as "1850"
+	RuleBeforeYear  Rule = "before year"  // a year qualified by before such as "bef. 1850"
+	RuleAfterYear   Rule = "after year"   // a year qualified by after such as "aft. 1850"
+	RuleAboutYear   Rule = "about year"   // a year qualified by about such as "abt. 1850"
+	RuleBeforeDate  Rule = "before date"  // a month, quarter or day qualified by before such as "bef. Mar 1850"
+	RuleAfterDate   Rule = "after date"   // a month, quarter or day qualified by after such as "after Jun qtr 1850"
+	RuleMonthYear   Rule = "month year"   // a month and year such as "Mar 1850"
+	RuleQuarter     Rule = "quarter"      // a GRO quarter such as "Q1 1850" or "Mar 1850" when AssumeGROQuarter is set
+	RuleYearRange   Rule = "year range"   // a range of years such as "1850-1855"
+	RuleDecade      Rule = "decade"       // a decade or part of a decade such as "1850s" or "early 1850s"
+	RuleCentury     Rule = "century"      // a century or part of a century such as "19th century"
+	RuleYearPart    Rule = "year part"    // part of a year such as "first half of 1850"
+	RuleSeason      Rule = "season"       // a season such as "Spring 1850"
+	RuleQuarterDay  Rule = "quarter day"  // a quarter day such as "Michaelmas 1850"
+	RuleFeast       Rule = "feast"        // a feast day such as "Easter Monday 1850"
+	RuleRelativeDay Rule = "relative day" // a day relative to another such as "Tuesday after Michaelmas 1850"
+	RulePartial     Rule = "partial"      // a date with unknown components such as "5 Mar 18__" or "Mar 185?"
+	RuleOneOf       Rule = "one of"       // a list of possible dates such as "1850 or 1851" or "[1850,1851]"
+	RuleAllOf       Rule = "all of"       // a list of dates such as "5, 12 and 19 Mar 1850" or "{1850,1851}"
+	RulePeriod      Rule = "period"       // a period with a start, an end or both such as "from 1850 to 1860" or "1871-"
+)
+
+// An Alternative is an interpretation of a string that the parser considered but did not choose.
+type Alternative struct {
+	Date  Date
+	Rule  Rule
+	Score float64 // likelihood of the interpretation, from 0 to 1
+}
+
+// AssumptionKind identifies the type of an Assumption
+type AssumptionKind int
+
+const (
+	AssumptionCalendar     AssumptionKind = 1 // the calendar was assigned from the reckoning location
+	AssumptionGROQuarter   AssumptionKind = 2 // a month was assumed to refer to a GRO quarter
+	AssumptionTwoDigitYear AssumptionKind = 3 // the century of a two digit year was assumed
+	AssumptionCentury      AssumptionKind = 4 // a decade ending in 00 was assumed to refer to a century
+	AssumptionHemisphere   AssumptionKind = 5 // the months of a season were assumed from the hemisphere
+)
+
+// An Assumption describes a choice the parser made that is not determined by the text being parsed.
+type Assumption struct {
+	Kind        AssumptionKind
+	Description string
+}
+
+// A Result describes how the parser interpreted a string.
+type Result struct {
+	Date Date
+
+	// Rule is the form of date that was recognised.
+	Rule Rule
+
+	// Confidence is the likelihood that Date is the correct interpretation, from 0 to 1.
+	// It is 1 when the text could only be interpreted one way and no assumptions were needed
+	// to choose between interpretations, and 0 when no date was recognised.
+	Confidence float64
+
+	// Alternatives are the other interpretations considered by the parser, most likely first.
+	Alternatives []Alternative
+
+	// Assumptions are the choices the parser made that were not determined by the text.
+	Assumptions []Assumption
+
+	// Warnings describe problems found with the date such as a mismatched weekday.
+	Warnings []Warning
+}
+
+// Interpret parses s in the same way as Parse and reports how the date was interpreted, including
+// the alternative interpretations that were considered and the assumptions made by the parser.
+// Dates with a low Confidence may benefit from being checked by a person.
+func (p *Parser) Interpret(s string) (*Result, error) {
+	st := newParseState()
+	d := p.parseInput(s, st)
+	if IsUnknown(d) {
+		return &Result{Date: d, Rule: RuleUnknown, Warnings: st.warnings}, nil
+	}
+
+	if p.ReckoningLocation != ReckoningLocationNone {
+		st.assume(AssumptionCalendar, "%s calendar assumed for %s", d.Calendar(), p.ReckoningLocation)
+	}
+	p.considerNewStyle(st, d)
+
+	sort.SliceStable(st.alternatives, func(i, j int) bool {
+		return st.alternatives[i].Score > st.alternatives[j].Score
+	})
+
+	return &Result{
+		Date:         d,
+		Rule:         st.rule,
+		Confidence:   st.confidence,
+		Alternatives: st.alternatives,
+		Assumptions:  st.assumptions,
+		Warnings:     st.warnings,
+	}, nil
+}
+
+// considerNewStyle records the interpretation of an Old Style date early in the year as if the writer
+// had numbered the year from 1 Jan as an alternative to d, when the calendar was assumed from the
+// reckoning location. The parts of a larger date are considered as they are parsed.
+func (p *Parser) considerNewStyle(st *parseState, d Date) {
+	if p.ReckoningLocation == ReckoningLocationNone {
+		return
+	}
+	if alt := newStyleAlternative(d); alt != nil {
+		st.choose(0.7, Alternative{Date: alt, Rule: st.rule, Score: 0.3})
+	}
+}
+
+// newStyleAlternative returns the interpretation of an Old Style date early in the year if the writer
+// had numbered the year from 1 Jan, or nil if the year of the date is not ambiguous.
+func newStyleAlternative(d Date) Date {
+	switch td := d.(type) {
+	case *Precise:
+		if td.C == Julian25Mar && (td.M < 3 || (td.M == 3 && td.D < 25)) {
+			return &Precise{C: td.C, Y: td.Y - 1, M: td.M, D: td.D}
+		}
+	case *MonthYear:
+		if td.C == Julian25Mar && td.M < 3 {
+			return &MonthYear{C: td.C, Y: td.Y - 1, M: td.M}
+		}
+	}
+	return nil
+}
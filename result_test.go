@@ -0,0 +1,229 @@
+package gdate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInterpret(t *testing.T) {
+	testCases := []struct {
+		s            string
+		p            Parser
+		want         Date
+		rule         Rule
+		confidence   float64
+		alternatives []Alternative
+		assumptions  []AssumptionKind
+	}{
+		{
+			s:          "5 Mar 1850",
+			want:       &Precise{Y: 1850, M: 3, D: 5},
+			rule:       RulePrecise,
+			confidence: 1,
+		},
+		{
+			s:          "Mar 1850",
+			want:       &MonthYear{Y: 1850, M: 3},
+			rule:       RuleMonthYear,
+			confidence: 0.7,
+			alternatives: []Alternative{
+				{Date: &YearQuarter{Y: 1850, Q: 1}, Rule: RuleQuarter, Score: 0.3},
+			},
+		},
+		{
+			s:          "Mar 1850",
+			p:          Parser{AssumeGROQuarter: true},
+			want:       &YearQuarter{Y: 1850, Q: 1},
+			rule:       RuleQuarter,
+			confidence: 0.6,
+			alternatives: []Alternative{
+				{Date: &MonthYear{Y: 1850, M: 3}, Rule: RuleMonthYear, Score: 0.4},
+			},
+			assumptions: []AssumptionKind{AssumptionGROQuarter},
+		},
+		{
+			s: "Jan 1850 or Jul 1851",
+			p: Parser{AssumeGROQuarter: true},
+			want: &OneOf{Dates: []Date{
+				&YearQuarter{Y: 1850, Q: 1},
+				&YearQuarter{Y: 1851, Q: 3},
+			}},
+			rule:       RuleOneOf,
+			confidence: 0.36,
+			alternatives: []Alternative{
+				{
+					Date: &OneOf{Dates: []Date{&YearQuarter{Y: 1850, Q: 1}, &MonthYear{Y: 1851, M: 7}}},
+					Rule: RuleOneOf, Score: 0.24,
+				},
+				{
+					Date: &OneOf{Dates: []Date{&MonthYear{Y: 1850, M: 1}, &YearQuarter{Y: 1851, Q: 3}}},
+					Rule: RuleOneOf, Score: 0.24,
+				},
+			},
+			assumptions: []AssumptionKind{AssumptionGROQuarter, AssumptionGROQuarter},
+		},
+		{
+			s:          "bef. Mar 1850",
+			p:          Parser{AssumeGROQuarter: true},
+			want:       &BeforeQuarter{Y: 1850, Q: 1},
+			rule:       RuleBeforeDate,
+			confidence: 0.6,
+			alternatives: []Alternative{
+				{Date: &BeforeMonthYear{Y: 1850, M: 3}, Rule: RuleBeforeDate, Score: 0.4},
+			},
+			assumptions: []AssumptionKind{AssumptionGROQuarter},
+		},
+		{
+			s:          "from Mar 1850 to Jun 1851",
+			p:          Parser{AssumeGROQuarter: true},
+			want:       &Period{Start: &YearQuarter{Y: 1850, Q: 1}, End: &YearQuarter{Y: 1851, Q: 2}},
+			rule:       RulePeriod,
+			confidence: 0.36,
+			alternatives: []Alternative{
+				{
+					Date: &Period{Start: &MonthYear{Y: 1850, M: 3}, End: &YearQuarter{Y: 1851, Q: 2}},
+					Rule: RulePeriod, Score: 0.24,
+				},
+				{
+					Date: &Period{Start: &YearQuarter{Y: 1850, Q: 1}, End: &MonthYear{Y: 1851, M: 6}},
+					Rule: RulePeriod, Score: 0.24,
+				},
+			},
+			assumptions: []AssumptionKind{AssumptionGROQuarter, AssumptionGROQuarter},
+		},
+		{
+			s:          "Q1 1850",
+			p:          Parser{AssumeGROQuarter: true},
+			want:       &YearQuarter{Y: 1850, Q: 1},
+			rule:       RuleQuarter,
+			confidence: 1,
+		},
+		{
+			s:          "5 Mar 52",
+			p:          Parser{TwoDigitYearPivot: 1899},
+			want:       &Precise{Y: 1852, M: 3, D: 5},
+			rule:       RulePrecise,
+			confidence: 0.8,
+			alternatives: []Alternative{
+				{Date: &Precise{Y: 1752, M: 3, D: 5}, Rule: RulePrecise, Score: 0.1},
+				{Date: &Precise{Y: 1952, M: 3, D: 5}, Rule: RulePrecise, Score: 0.1},
+			},
+			assumptions: []AssumptionKind{AssumptionTwoDigitYear},
+		},
+		{
+			s:          "1800s",
+			want:       &Century{N: 19},
+			rule:       RuleCentury,
+			confidence: 0.7,
+			alternatives: []Alternative{
+				{Date: &Decade{Y: 1800}, Rule: RuleDecade, Score: 0.3},
+			},
+			assumptions: []AssumptionKind{AssumptionCentury},
+		},
+		{
+			s:          "2 Feb 1720",
+			p:          Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want:       &Precise{C: Julian25Mar, Y: 1720, M: 2, D: 2},
+			rule:       RulePrecise,
+			confidence: 0.7,
+			alternatives: []Alternative{
+				{Date: &Precise{C: Julian25Mar, Y: 1719, M: 2, D: 2}, Rule: RulePrecise, Score: 0.3},
+			},
+			assumptions: []AssumptionKind{AssumptionCalendar},
+		},
+		{
+			s: "5 or 6 Feb 1720",
+			p: Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want: &OneOf{Dates: []Date{
+				&Precise{C: Julian25Mar, Y: 1720, M: 2, D: 5},
+				&Precise{C: Julian25Mar, Y: 1720, M: 2, D: 6},
+			}},
+			rule:       RuleOneOf,
+			confidence: 0.49,
+			alternatives: []Alternative{
+				{
+					Date: &OneOf{Dates: []Date{&Precise{C: Julian25Mar, Y: 1720, M: 2, D: 5}, &Precise{C: Julian25Mar, Y: 1719, M: 2, D: 6}}},
+					Rule: RuleOneOf, Score: 0.21,
+				},
+				{
+					Date: &OneOf{Dates: []Date{&Precise{C: Julian25Mar, Y: 1719, M: 2, D: 5}, &Precise{C: Julian25Mar, Y: 1720, M: 2, D: 6}}},
+					Rule: RuleOneOf, Score: 0.21,
+				},
+			},
+			assumptions: []AssumptionKind{AssumptionCalendar},
+		},
+		{
+			s:          "bef. 2 Feb 1720",
+			p:          Parser{ReckoningLocation: ReckoningLocationEnglandAndWales},
+			want:       &BeforePrecise{C: Julian25Mar, Y: 1720, M: 2, D: 2},
+			rule:       RuleBeforeDate,
+			confidence: 0.7,
+			alternatives: []Alternative{
+				{Date: &BeforePrecise{C: Julian25Mar, Y: 1719, M: 2, D: 2}, Rule: RuleBeforeDate, Score: 0.3},
+			},
+			assumptions: []AssumptionKind{AssumptionCalendar},
+		},
+		{
+			s:          "Winter 1850",
+			want:       &Season{Y: 1850, S: SeasonWinter},
+			rule:       RuleSeason,
+			confidence: 0.6,
+			alternatives: []Alternative{
+				{Date: &Season{Y: 1849, S: SeasonWinter}, Rule: RuleSeason, Score: 0.4},
+			},
+			assumptions: []AssumptionKind{AssumptionHemisphere},
+		},
+		{
+			s:          "Monday 5 March 1850",
+			want:       &Precise{Y: 1850, M: 3, D: 5},
+			rule:       RulePrecise,
+			confidence: 0.5,
+		},
+		{
+			s:          "Tuesday after Michaelmas 1850",
+			want:       &Precise{Y: 1850, M: 10, D: 1},
+			rule:       RuleRelativeDay,
+			confidence: 1,
+		},
+		{
+			s:          "not a date",
+			want:       &Unknown{Text: "not a date"},
+			rule:       RuleUnknown,
+			confidence: 0,
+		},
+	}
+
+	approx := cmp.Comparer(func(a, b float64) bool {
+		d := a - b
+		return d < 1e-9 && d > -1e-9
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.s, func(t *testing.T) {
+			res, err := tc.p.Interpret(tc.s)
+			if err != nil {
+				t.Fatalf("got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, res.Date); diff != "" {
+				t.Errorf("date mismatch (-want +got):\n%s", diff)
+			}
+			if res.Rule != tc.rule {
+				t.Errorf("got rule %q, want %q", res.Rule, tc.rule)
+			}
+			if diff := cmp.Diff(tc.confidence, res.Confidence, approx); diff != "" {
+				t.Errorf("confidence mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.alternatives, res.Alternatives, approx); diff != "" {
+				t.Errorf("alternatives mismatch (-want +got):\n%s", diff)
+			}
+			var kinds []AssumptionKind
+			for _, a := range res.Assumptions {
+				kinds = append(kinds, a.Kind)
+			}
+			if diff := cmp.Diff(tc.assumptions, kinds); diff != "" {
+				t.Errorf("assumptions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package gdate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hemisphere determines the months covered by a season.
+type Hemisphere int
+
+const (
+	HemisphereNorthern Hemisphere = 0
+	HemisphereSouthern Hemisphere = 1
+)
+
+func (h Hemisphere) String() string {
+	if h == HemisphereSouthern {
+		return "southern hemisphere"
+	}
+	return "northern hemisphere"
+}
+
+// SeasonKind identifies a season or other named period of the year.
+type SeasonKind int
+
+const (
+	SeasonSpring  SeasonKind = 1
+	SeasonSummer  SeasonKind = 2
+	SeasonAutumn  SeasonKind = 3
+	SeasonWinter  SeasonKind = 4
+	SeasonHarvest SeasonKind = 5
+)
+
+var seasonNames = []string{
+	SeasonSpring:  "Spring",
+	SeasonSummer:  "Summer",
+	SeasonAutumn:  "Autumn",
+	SeasonWinter:  "Winter",
+	SeasonHarvest: "Harvest",
+}
+
+// seasonMonths gives the first and last month of each season in the northern hemisphere.
+// Seasons in the southern hemisphere are six months later.
+var seasonMonths = [][2]int{
+	SeasonSpring:  {3, 5},
+	SeasonSummer:  {6, 8},
+	SeasonAutumn:  {9, 11},
+	SeasonWinter:  {12, 2},
+	SeasonHarvest: {8, 9},
+}
+
+// Season represents a date that falls within a season of a specific year. Seasons are
+// meteorological, so in the northern hemisphere spring is Mar-May, summer is Jun-Aug,
+// autumn is Sep-Nov and winter is Dec-Feb. Harvest is taken to be Aug-Sep. In the southern
+// hemisphere each season is six months later. A season that spans the end of the year, such as
+// winter in the northern hemisphere, belongs to the year in which it starts and is written as "Winter 1850/51".
+type Season struct {
+	C Calendar
+	Y int // year in which the season starts
+	S SeasonKind
+	H Hemisphere
+}
+
+func (s *Season) name() string {
+	if s.S < SeasonSpring || s.S > SeasonHarvest {
+		return "Unknown season"
+	}
+	return seasonNames[s.S]
+}
+
+// months returns the first and last month of the season, and whether the last month is in the following year.
+func (s *Season) months() (int, int, bool) {
+	if s.S < SeasonSpring || s.S > SeasonHarvest {
+		return 1, 12, false
+	}
+	first, last := seasonMonths[s.S][0], seasonMonths[s.S][1]
+	if s.H == HemisphereSouthern {
+		first = (first+5)%12 + 1
+		last = (last+5)%12 + 1
+	}
+	return first, last, last < first
+}
+
+func (s *Season) fmtYear() string {
+	if _, _, spans := s.months(); spans {
+		return fmt.Sprintf("%04d/%02d", s.Y, (s.Y+1)%100)
+	}
+	return fmt.Sprintf("%04d", s.Y)
+}
+
+func (s *Season) String() string {
+	return s.name() + " " + s.fmtYear()
+}
+
+func (s *Season) Occurrence() string {
+	return fmt.Sprintf("in the %s of %s", strings.ToLower(s.name()), s.fmtYear())
+}
+
+func (s *Season) Year() int {
+	return s.Y
+}
+
+func (s *Season) Calendar() Calendar {
+	return s.C
+}
+
+func (s *Season) EarliestJulianDay() int {
+	lo, _ := s.span()
+	return lo
+}
+
+func (s *Season) LatestJulianDay() int {
+	_, hi := s.span()
+	return hi
+}
+
+// span returns the first and last Julian days of the season
+func (s *Season) span() (int, int) {
+	first, last, spans := s.months()
+	if !spans {
+		return monthSpan(s.C, s.Y, first, last)
+	}
+	lo, _ := monthSpan(s.C, s.Y, first, 12)
+	y := s.Y
+	// With the Old Style calendar, January and February following December are in the same year
+	if !(s.C == Julian25Mar && last <= 2) {
+		y++
+	}
+	_, hi := monthSpan(s.C, y, 1, last)
+	return lo, hi
+}
+
+// QuarterDay represents one of the English quarter days of a specific year, on which rents
+// were due and contracts began.
+// Values of Q correspond to quarter days as follows:
+// 1 = Lady Day, 25 Mar
+// 2 = Midsummer, 24 Jun
+// 3 = Michaelmas, 29 Sep
+// 4 = Christmas, 25 Dec
+type QuarterDay struct {
+	C Calendar
+	Y int
+	Q int
+}
+
+var quarterDays = []struct {
+	name string
+	m, d int
+}{
+	1: {name: "Lady Day", m: 3, d: 25},
+	2: {name: "Midsummer", m: 6, d: 24},
+	3: {name: "Michaelmas", m: 9, d: 29},
+	4: {name: "Christmas", m: 12, d: 25},
+}
+
+func (q *QuarterDay) Name() string {
+	if q.Q < 1 || q.Q > 4 {
+		return "Unknown quarter day"
+	}
+	return quarterDays[q.Q].name
+}
+
+func (q *QuarterDay) String() string {
+	return fmt.Sprintf("%s %04d", q.Name(), q.Y)
+}
+
+func (q *QuarterDay) Occurrence() string {
+	return fmt.Sprintf("at %s %04d", q.Name(), q.Y)
+}
+
+func (q *QuarterDay) Year() int {
+	return q.Y
+}
+
+func (q *QuarterDay) Calendar() Calendar {
+	return q.C
+}
+
+// day returns the day on which the quarter day falls.
+func (q *QuarterDay) day() *Precise {
+	if q.Q < 1 || q.Q > 4 {
+		return &Precise{C: q.C, Y: q.Y, M: 1, D: 1}
+	}
+	return &Precise{C: q.C, Y: q.Y, M: quarterDays[q.Q].m, D: quarterDays[q.Q].d}
+}
+
+func (q *QuarterDay) EarliestJulianDay() int {
+	return q.day().EarliestJulianDay()
+}
+
+func (q *QuarterDay) LatestJulianDay() int {
+	return q.day().LatestJulianDay()
+}
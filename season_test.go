@@ -0,0 +1,155 @@
+package gdate
+
+import (
+	"testing"
+)
+
+func TestSeasonString(t *testing.T) {
+	testCases := []struct {
+		d          Date
+		str        string
+		occurrence string
+	}{
+		{
+			d:          &Season{Y: 1850, S: SeasonSpring},
+			str:        "Spring 1850",
+			occurrence: "in the spring of 1850",
+		},
+		{
+			d:          &Season{Y: 1850, S: SeasonWinter},
+			str:        "Winter 1850/51",
+			occurrence: "in the winter of 1850/51",
+		},
+		{
+			d:          &Season{Y: 1899, S: SeasonWinter},
+			str:        "Winter 1899/00",
+			occurrence: "in the winter of 1899/00",
+		},
+		{
+			d:          &Season{Y: 1850, S: SeasonWinter, H: HemisphereSouthern},
+			str:        "Winter 1850",
+			occurrence: "in the winter of 1850",
+		},
+		{
+			d:          &QuarterDay{Y: 1720, Q: 1},
+			str:        "Lady Day 1720",
+			occurrence: "at Lady Day 1720",
+		},
+		{
+			d:          &QuarterDay{Y: 1850, Q: 3},
+			str:        "Michaelmas 1850",
+			occurrence: "at Michaelmas 1850",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.str, func(t *testing.T) {
+			if got := tc.d.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+			if got := tc.d.Occurrence(); got != tc.occurrence {
+				t.Errorf("got Occurrence()=%q, want %q", got, tc.occurrence)
+			}
+		})
+	}
+}
+
+func TestSeasonBounds(t *testing.T) {
+	testCases := []struct {
+		d        ComparableDate
+		earliest *Precise
+		latest   *Precise
+	}{
+		{
+			d:        &Season{Y: 1850, S: SeasonSpring},
+			earliest: &Precise{Y: 1850, M: 3, D: 1},
+			latest:   &Precise{Y: 1850, M: 5, D: 31},
+		},
+		{
+			d:        &Season{Y: 1850, S: SeasonWinter},
+			earliest: &Precise{Y: 1850, M: 12, D: 1},
+			latest:   &Precise{Y: 1851, M: 2, D: 28},
+		},
+		{
+			d:        &Season{Y: 1851, S: SeasonWinter},
+			earliest: &Precise{Y: 1851, M: 12, D: 1},
+			latest:   &Precise{Y: 1852, M: 2, D: 29},
+		},
+		{
+			d:        &Season{Y: 1850, S: SeasonHarvest},
+			earliest: &Precise{Y: 1850, M: 8, D: 1},
+			latest:   &Precise{Y: 1850, M: 9, D: 30},
+		},
+		{
+			d:        &Season{Y: 1850, S: SeasonSpring, H: HemisphereSouthern},
+			earliest: &Precise{Y: 1850, M: 9, D: 1},
+			latest:   &Precise{Y: 1850, M: 11, D: 30},
+		},
+		{
+			d:        &Season{Y: 1850, S: SeasonSummer, H: HemisphereSouthern},
+			earliest: &Precise{Y: 1850, M: 12, D: 1},
+			latest:   &Precise{Y: 1851, M: 2, D: 28},
+		},
+		{
+			// Old Style: Jan and Feb following Dec 1720 are still in 1720
+			d:        &Season{Y: 1720, S: SeasonWinter, C: Julian25Mar},
+			earliest: &Precise{Y: 1720, M: 12, D: 1, C: Julian},
+			latest:   &Precise{Y: 1721, M: 2, D: 28, C: Julian},
+		},
+		{
+			// Old Style: 1-24 Mar 1720 fall at the end of the year as written, so spring spans the year
+			d:        &Season{Y: 1720, S: SeasonSpring, C: Julian25Mar},
+			earliest: &Precise{Y: 1720, M: 3, D: 25, C: Julian},
+			latest:   &Precise{Y: 1721, M: 3, D: 24, C: Julian},
+		},
+		{
+			d:        &Season{Y: 1720, S: SeasonSummer, C: Julian25Mar},
+			earliest: &Precise{Y: 1720, M: 6, D: 1, C: Julian},
+			latest:   &Precise{Y: 1720, M: 8, D: 31, C: Julian},
+		},
+		{
+			d:        &QuarterDay{Y: 1720, Q: 1, C: Julian25Mar},
+			earliest: &Precise{Y: 1720, M: 3, D: 25, C: Julian},
+			latest:   &Precise{Y: 1720, M: 3, D: 25, C: Julian},
+		},
+		{
+			d:        &QuarterDay{Y: 1850, Q: 4},
+			earliest: &Precise{Y: 1850, M: 12, D: 25},
+			latest:   &Precise{Y: 1850, M: 12, D: 25},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.(Date).String(), func(t *testing.T) {
+			if got, want := tc.d.EarliestJulianDay(), tc.earliest.EarliestJulianDay(); got != want {
+				t.Errorf("got earliest %d, want %d (%s)", got, want, tc.earliest)
+			}
+			if got, want := tc.d.LatestJulianDay(), tc.latest.LatestJulianDay(); got != want {
+				t.Errorf("got latest %d, want %d (%s)", got, want, tc.latest)
+			}
+		})
+	}
+}
+
+func TestSeasonSortsWithQuarters(t *testing.T) {
+	dates := []Date{
+		&YearQuarter{Y: 1850, Q: 1},
+		&QuarterDay{Y: 1850, Q: 1},
+		&YearQuarter{Y: 1850, Q: 2},
+		&Season{Y: 1850, S: SeasonSummer},
+		&QuarterDay{Y: 1850, Q: 2},
+		&YearQuarter{Y: 1850, Q: 3},
+		&QuarterDay{Y: 1850, Q: 3},
+		&Season{Y: 1850, S: SeasonWinter},
+		&QuarterDay{Y: 1850, Q: 4},
+	}
+
+	for i := 0; i < len(dates)-1; i++ {
+		if !SortsBefore(dates[i], dates[i+1]) {
+			t.Errorf("got SortsBefore(%q,%q)=false, wanted true", dates[i], dates[i+1])
+		}
+		if SortsBefore(dates[i+1], dates[i]) {
+			t.Errorf("got SortsBefore(%q,%q)=true, wanted false", dates[i+1], dates[i])
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package gdate
+
+import (
+	"strings"
+)
+
+// OneOf represents a date that is one of a set of dates, such as "1850 or 1851" when sources disagree.
+// It corresponds to an EDTF set written as [1850,1851]. Its bounds span every date in the set, and are
+// open at the start or end if any date in the set is, as an Unknown date or "bef. 1850" is.
+type OneOf struct {
+	Dates []Date
+}
+
+func (o *OneOf) String() string {
+	return joinDates(o.Dates, Date.String, ", ", " or ")
+}
+
+func (o *OneOf) Occurrence() string {
+	return joinDates(o.Dates, Date.Occurrence, ", ", " or ")
+}
+
+func (o *OneOf) Calendar() Calendar {
+	return setCalendar(o.Dates)
+}
+
+func (o *OneOf) EarliestJulianDay() int {
+	lo, _ := OpenBounds.setBounds(o.Dates)
+	return lo
+}
+
+func (o *OneOf) LatestJulianDay() int {
+	_, hi := OpenBounds.setBounds(o.Dates)
+	return hi
+}
+
+// AllOf represents every date in a set of dates, such as the days on which an event recurred.
+// It corresponds to an EDTF set written as {1850,1851}. Its bounds span every date in the set, and are
+// open at the start or end if any date in the set is.
+type AllOf struct {
+	Dates []Date
+}
+
+func (a *AllOf) String() string {
+	return joinDates(a.Dates, Date.String, ", ", " and ")
+}
+
+func (a *AllOf) Occurrence() string {
+	return joinDates(a.Dates, Date.Occurrence, ", ", " and ")
+}
+
+func (a *AllOf) Calendar() Calendar {
+	return setCalendar(a.Dates)
+}
+
+func (a *AllOf) EarliestJulianDay() int {
+	lo, _ := OpenBounds.setBounds(a.Dates)
+	return lo
+}
+
+func (a *AllOf) LatestJulianDay() int {
+	_, hi := OpenBounds.setBounds(a.Dates)
+	return hi
+}
+
+// joinDates formats each date with format and joins them with sep, using last before the final date
+func joinDates(dates []Date, format func(Date) string, sep, last string) string {
+	var b strings.Builder
+	for i, d := range dates {
+		switch {
+		case i == 0:
+		case i == len(dates)-1:
+			b.WriteString(last)
+		default:
+			b.WriteString(sep)
+		}
+		b.WriteString(format(d))
+	}
+	return b.String()
+}
+
+// setCalendar returns the calendar of the first date in a set
+func setCalendar(dates []Date) Calendar {
+	if len(dates) == 0 {
+		return Gregorian
+	}
+	return dates[0].Calendar()
+}
+
+// maxSetYears is the largest range of years, as in the EDTF set [1850..1860], that is expanded into a set
+// of years
+const maxSetYears = 100
+
+// list parses lists of dates such as "1850 or 1851", "5 or 6 Mar 1850" or "5, 12 and 19 Mar 1850", and
+// EDTF sets such as "[1850,1851]" or "{1850-03-05,1850-03-12}". A list joined by "or" is one of its
+// dates and any other list is all of them. Items that lack a month or year, such as the 5 of
+// "5 or 6 Mar 1850", take them from the final item.
+func (g *grammar) list() Date {
+	if g.item || len(g.toks) < 3 {
+		return nil
+	}
+	if d := g.edtfSet(); d != nil {
+		return d
+	}
+
+	// Split the tokens into segments at commas and conjunctions. A comma before the conjunction,
+	// as in "5, 6, or 7 Mar 1850", is allowed.
+	type segment struct {
+		lo, hi int  // the range of tokens in the segment
+		comma  bool // the segment is followed by a comma
+	}
+	var buf [8]segment
+	segs := buf[:0]
+	conj := ""
+	lo := 0
+	for i, t := range g.toks {
+		isComma := t.is(",")
+		if !isComma && !t.is("or") && !t.is("and") {
+			continue
+		}
+		if !isComma {
+			w := strings.ToLower(t.text)
+			if conj != "" && conj != w {
+				return nil
+			}
+			conj = w
+		}
+		if i == lo {
+			if isComma || len(segs) == 0 || !segs[len(segs)-1].comma {
+				return nil
+			}
+			segs[len(segs)-1].comma = false
+			lo = i + 1
+			continue
+		}
+		segs = append(segs, segment{lo: lo, hi: i, comma: isComma})
+		lo = i + 1
+	}
+	if lo == 0 || lo == len(g.toks) {
+		return nil
+	}
+	segs = append(segs, segment{lo: lo, hi: len(g.toks)})
+
+	// Commas may also fall within a date, as in "March 5, 1850", so the longest run of segments joined
+	// by commas that forms a date is taken as an item. The final item must be a date and is found first
+	// since it completes the items before it that lack a month or year.
+	st := newParseState()
+	var final Date
+	last := len(segs) - 1
+	first := last
+	for first > 0 && segs[first-1].comma {
+		first--
+	}
+	for ; first <= last; first++ {
+		if final = g.bounded(st, g.s, g.toks[segs[first].lo:]); final != nil {
+			break
+		}
+	}
+	if final == nil || first == 0 {
+		return nil
+	}
+	finalToks := g.toks[segs[first].lo:]
+
+	var dates []Date
+	for k := 0; k < first; {
+		j := k
+		for j < first-1 && segs[j].comma {
+			j++
+		}
+		var d Date
+		for ; j >= k; j-- {
+			if d = g.bounded(st, g.s, g.toks[segs[k].lo:segs[j].hi]); d != nil {
+				break
+			}
+		}
+		if d == nil {
+			j = k
+			if d = g.completeItem(st, g.toks[segs[k].lo:segs[k].hi], finalToks); d == nil {
+				return nil
+			}
+		}
+		dates = append(dates, d)
+		k = j + 1
+	}
+	dates = append(dates, final)
+
+	return g.set(st, dates, conj != "or")
+}
+
+// completeItem parses an item of a list that lacks a month or year, such as the 5 of "5 or 6 Mar 1850",
+// by appending the shortest part of the end of the final item that completes it.
+func (g *grammar) completeItem(st *parseState, toks, final []token) Date {
+	head := g.s[toks[0].pos:toks[len(toks)-1].end()]
+	for j := 1; j < len(final); j++ {
+		s := head + " " + g.s[final[j].pos:final[len(final)-1].end()]
+		if d := g.bounded(st, s, tokenize(s)); d != nil {
+			return d
+		}
+	}
+	return nil
+}
+
+// edtfSet parses an EDTF set of dates, such as "[1850,1851]" for one of the dates or "{1850,1851}" for
+// all of them. A range of years such as "1850..1853" within a set stands for each of the years.
+func (g *grammar) edtfSet() Date {
+	n := len(g.toks)
+	all := false
+	switch {
+	case g.at(0, "[") && g.at(n-1, "]"):
+	case g.at(0, "{") && g.at(n-1, "}"):
+		all = true
+	default:
+		return nil
+	}
+
+	var dates []Date
+	st := newParseState()
+	lo := 1
+	for i := 1; i < n; i++ {
+		if i < n-1 && !g.at(i, ",") {
+			continue
+		}
+		if i == lo {
+			return nil
+		}
+		toks := g.toks[lo:i]
+		if len(toks) == 4 && toks[1].is(".") && toks[2].is(".") {
+			ylo, lok := g.year(lo)
+			yhi, hok := g.year(lo + 3)
+			if !lok || !hok || ylo > yhi || yhi-ylo > maxSetYears {
+				return nil
+			}
+			for y := ylo; y <= yhi; y++ {
+				dates = append(dates, &Year{C: g.p.calendar(y), Y: y})
+			}
+		} else {
+			d := g.bounded(st, g.s, toks)
+			if d == nil {
+				return nil
+			}
+			dates = append(dates, d)
+		}
+		lo = i + 1
+	}
+
+	return g.set(st, dates, all)
+}
+
+// set returns a OneOf of the dates parsed by a rule, or an AllOf if all is true
+func (g *grammar) set(st *parseState, dates []Date, all bool) Date {
+	if all {
+		g.keep(st, RuleAllOf, func(part, alt Date) Date {
+			return &AllOf{Dates: replaced(dates, part, alt)}
+		})
+		return &AllOf{Dates: dates}
+	}
+	g.keep(st, RuleOneOf, func(part, alt Date) Date {
+		return &OneOf{Dates: replaced(dates, part, alt)}
+	})
+	return &OneOf{Dates: dates}
+}
+
+// bounded parses the tokens of s as part of a larger date, such as an item of a list or the end of a period,
+// which must be a date with bounds. Warnings, assumptions and the confidence in the interpretation of the
+// part are recorded in st, along with the alternative interpretations of the part.
+func (g *grammar) bounded(st *parseState, s string, toks []token) Date {
+	ist := newParseState()
+	sub := grammar{p: g.p, st: ist, s: s, toks: toks, item: true}
+	d := sub.parse()
+	if _, ok := d.(ComparableDate); !ok {
+		return nil
+	}
+	g.p.considerNewStyle(ist, d)
+	st.warnings = append(st.warnings, ist.warnings...)
+	st.assumptions = append(st.assumptions, ist.assumptions...)
+	st.confidence *= ist.confidence
+	for _, alt := range ist.alternatives {
+		st.parts = append(st.parts, partAlternative{part: d, confidence: ist.confidence, alt: alt})
+	}
+	return d
+}
+
+// keep records the warnings, assumptions and confidence of the parts of a date parsed by the rule. Each
+// alternative interpretation of a part becomes an alternative for the whole date, built by whole from the
+// part and its alternative, which may return nil if the alternative cannot form part of the date.
+func (g *grammar) keep(st *parseState, rule Rule, whole func(part, alt Date) Date) {
+	g.st.warnings = append(g.st.warnings, st.warnings...)
+	g.st.assumptions = append(g.st.assumptions, st.assumptions...)
+	for _, pa := range st.parts {
+		if d := whole(pa.part, pa.alt.Date); d != nil {
+			// The other parts keep their chosen interpretations
+			score := pa.alt.Score * st.confidence / pa.confidence
+			g.st.choose(1, Alternative{Date: d, Rule: rule, Score: score})
+		}
+	}
+	g.st.confidence *= st.confidence
+	g.st.rule = rule
+}
+
+// partAlternative is an alternative interpretation of a part of a larger date
+type partAlternative struct {
+	part       Date    // the chosen interpretation of the part
+	confidence float64 // the confidence in the chosen interpretation of the part
+	alt        Alternative
+}
+
+// replaced returns a copy of dates with the date part replaced by alt
+func replaced(dates []Date, part, alt Date) []Date {
+	res := make([]Date, len(dates))
+	for i, d := range dates {
+		if d == part {
+			d = alt
+		}
+		res[i] = d
+	}
+	return res
+}
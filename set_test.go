@@ -0,0 +1,106 @@
+package gdate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSetString(t *testing.T) {
+	testCases := []struct {
+		d          Date
+		str        string
+		occurrence string
+	}{
+		{
+			d:          &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}}},
+			str:        "1850 or 1851",
+			occurrence: "in 1850 or in 1851",
+		},
+		{
+			d:          &OneOf{Dates: []Date{&Year{Y: 1850}, &Year{Y: 1851}, &Year{Y: 1852}}},
+			str:        "1850, 1851 or 1852",
+			occurrence: "in 1850, in 1851 or in 1852",
+		},
+		{
+			d:          &AllOf{Dates: []Date{&Precise{Y: 1850, M: 3, D: 5}, &Precise{Y: 1850, M: 3, D: 12}, &Precise{Y: 1850, M: 3, D: 19}}},
+			str:        "5 Mar 1850, 12 Mar 1850 and 19 Mar 1850",
+			occurrence: "on 5 Mar, 1850, on 12 Mar, 1850 and on 19 Mar, 1850",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.str, func(t *testing.T) {
+			if got := tc.d.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+			if got := tc.d.Occurrence(); got != tc.occurrence {
+				t.Errorf("got Occurrence()=%q, want %q", got, tc.occurrence)
+			}
+		})
+	}
+}
+
+func TestSetBounds(t *testing.T) {
+	testCases := []struct {
+		d        ComparableDate
+		earliest *Precise
+		latest   *Precise
+	}{
+		{
+			d:        &OneOf{Dates: []Date{&Year{Y: 1851}, &Year{Y: 1850}}},
+			earliest: &Precise{Y: 1850, M: 1, D: 1},
+			latest:   &Precise{Y: 1851, M: 12, D: 31},
+		},
+		{
+			d:        &AllOf{Dates: []Date{&Precise{Y: 1850, M: 3, D: 5}, &MonthYear{Y: 1850, M: 4}}},
+			earliest: &Precise{Y: 1850, M: 3, D: 5},
+			latest:   &Precise{Y: 1850, M: 4, D: 30},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.(Date).String(), func(t *testing.T) {
+			if got, want := tc.d.EarliestJulianDay(), tc.earliest.EarliestJulianDay(); got != want {
+				t.Errorf("got earliest %d, want %d (%s)", got, want, tc.earliest)
+			}
+			if got, want := tc.d.LatestJulianDay(), tc.latest.LatestJulianDay(); got != want {
+				t.Errorf("got latest %d, want %d (%s)", got, want, tc.latest)
+			}
+		})
+	}
+}
+
+func TestSetBoundsOpen(t *testing.T) {
+	// A set with a date that has no bounds is open rather than falling on Julian day 0
+	testCases := []ComparableDate{
+		&OneOf{},
+		&OneOf{Dates: []Date{&Year{Y: 1850}, &Unknown{}}},
+		&OneOf{Dates: []Date{&BeforeYear{Y: 1840}, &AboutYear{Y: 1850}}},
+		&AllOf{Dates: []Date{&Unknown{Text: "not a date"}}},
+	}
+
+	for _, d := range testCases {
+		t.Run(d.(Date).String(), func(t *testing.T) {
+			if got := d.EarliestJulianDay(); got != math.MinInt {
+				t.Errorf("got earliest %d, want math.MinInt", got)
+			}
+			if got := d.LatestJulianDay(); got != math.MaxInt {
+				t.Errorf("got latest %d, want math.MaxInt", got)
+			}
+			if SortsBefore(d.(Date), &Year{Y: 1850}) {
+				t.Errorf("%s sorts before 1850, want it to sort with unknown dates", d)
+			}
+			if !SortsBefore(&Year{Y: 1850}, d.(Date)) {
+				t.Errorf("1850 does not sort before %s, want it to sort with unknown dates", d)
+			}
+		})
+	}
+
+	d := &OneOf{Dates: []Date{&BeforeYear{Y: 1840}, &Year{Y: 1850}}}
+	if got := d.EarliestJulianDay(); got != math.MinInt {
+		t.Errorf("got earliest %d for %s, want math.MinInt", got, d)
+	}
+	if got, want := d.LatestJulianDay(), (&Precise{Y: 1850, M: 12, D: 31}).LatestJulianDay(); got != want {
+		t.Errorf("got latest %d for %s, want %d", got, d, want)
+	}
+}
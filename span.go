@@ -0,0 +1,179 @@
+package gdate
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Part identifies a portion of a year, decade or century, such as the "early 1850s" or the
+// "first half of the 19th century". The boundaries of each part are:
+//
+//	             year        decade     century
+//	early        Jan-Apr     0-3        00-32
+//	mid          May-Aug     4-6        33-66
+//	late         Sep-Dec     7-9        67-99
+//	first half   Jan-Jun     0-4        00-49
+//	second half  Jul-Dec     5-9        50-99
+//
+// where the decade and century columns give the final digits of the years included.
+type Part int
+
+const (
+	PartWhole      Part = 0
+	PartEarly      Part = 1
+	PartMid        Part = 2
+	PartLate       Part = 3
+	PartFirstHalf  Part = 4
+	PartSecondHalf Part = 5
+)
+
+// partBounds gives the first and last unit of each part, counting from zero, for periods of 12 months,
+// 10 years and 100 years.
+var partBounds = map[int][6][2]int{
+	12:  {{0, 11}, {0, 3}, {4, 7}, {8, 11}, {0, 5}, {6, 11}},
+	10:  {{0, 9}, {0, 3}, {4, 6}, {7, 9}, {0, 4}, {5, 9}},
+	100: {{0, 99}, {0, 32}, {33, 66}, {67, 99}, {0, 49}, {50, 99}},
+}
+
+// bounds returns the first and last unit of the part within a period of n units.
+func (p Part) bounds(n int) (int, int) {
+	if p < PartWhole || p > PartSecondHalf {
+		return 0, n - 1
+	}
+	b := partBounds[n][p]
+	return b[0], b[1]
+}
+
+// qualify prefixes s with the name of the part, using the article when the part is a half.
+func (p Part) qualify(s string, article string) string {
+	switch p {
+	case PartEarly:
+		return "early " + s
+	case PartMid:
+		return "mid-" + s
+	case PartLate:
+		return "late " + s
+	case PartFirstHalf:
+		return "first half of " + article + s
+	case PartSecondHalf:
+		return "second half of " + article + s
+	}
+	return s
+}
+
+// YearPart represents a date that falls within part of a specific year, such as "early 1850" or
+// the "first half of 1850".
+type YearPart struct {
+	C    Calendar
+	Y    int
+	Part Part
+}
+
+func (y *YearPart) String() string {
+	return y.Part.qualify(fmt.Sprintf("%04d", y.Y), "")
+}
+
+func (y *YearPart) Occurrence() string {
+	if y.Part == PartFirstHalf || y.Part == PartSecondHalf {
+		return "in the " + y.String()
+	}
+	return "in " + y.String()
+}
+
+func (y *YearPart) Year() int {
+	return y.Y
+}
+
+func (y *YearPart) Calendar() Calendar {
+	return y.C
+}
+
+func (y *YearPart) EarliestJulianDay() int {
+	lo, hi := y.Part.bounds(12)
+	first, _ := monthSpan(y.C, y.Y, lo+1, hi+1)
+	return first
+}
+
+func (y *YearPart) LatestJulianDay() int {
+	lo, hi := y.Part.bounds(12)
+	_, last := monthSpan(y.C, y.Y, lo+1, hi+1)
+	return last
+}
+
+// Decade represents a date that falls within a decade, such as the 1850s, or part of a decade, such as the
+// early 1850s.
+type Decade struct {
+	C    Calendar
+	Y    int // first year of the decade
+	Part Part
+}
+
+func (d *Decade) String() string {
+	return d.Part.qualify(strconv.Itoa(d.Y)+"s", "the ")
+}
+
+func (d *Decade) Occurrence() string {
+	return "in the " + d.String()
+}
+
+func (d *Decade) Calendar() Calendar {
+	return d.C
+}
+
+func (d *Decade) EarliestJulianDay() int {
+	lo, _ := d.Part.bounds(10)
+	return yearStart(d.C, d.Y+lo)
+}
+
+func (d *Decade) LatestJulianDay() int {
+	_, hi := d.Part.bounds(10)
+	return yearEnd(d.C, d.Y+hi)
+}
+
+// Century represents a date that falls within a century, such as the 19th century, or part of a century,
+// such as the late 18th century. Following common usage, the 19th century is taken to be the
+// years 1800 to 1899.
+type Century struct {
+	C    Calendar
+	N    int // ordinal number of the century, 19 for the 19th century
+	Part Part
+}
+
+func (c *Century) String() string {
+	return c.Part.qualify(ordinal(c.N)+" century", "the ")
+}
+
+func (c *Century) Occurrence() string {
+	return "in the " + c.String()
+}
+
+func (c *Century) Calendar() Calendar {
+	return c.C
+}
+
+func (c *Century) EarliestJulianDay() int {
+	lo, _ := c.Part.bounds(100)
+	return yearStart(c.C, (c.N-1)*100+lo)
+}
+
+func (c *Century) LatestJulianDay() int {
+	_, hi := c.Part.bounds(100)
+	return yearEnd(c.C, (c.N-1)*100+hi)
+}
+
+// ordinal formats n as an English ordinal number such as 1st, 2nd or 19th.
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return strconv.Itoa(n) + suffix
+}
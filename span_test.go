@@ -0,0 +1,156 @@
+package gdate
+
+import (
+	"testing"
+)
+
+func TestSpanString(t *testing.T) {
+	testCases := []struct {
+		d          Date
+		str        string
+		occurrence string
+	}{
+		{
+			d:          &Decade{Y: 1850},
+			str:        "1850s",
+			occurrence: "in the 1850s",
+		},
+		{
+			d:          &Decade{Y: 1850, Part: PartEarly},
+			str:        "early 1850s",
+			occurrence: "in the early 1850s",
+		},
+		{
+			d:          &Decade{Y: 1850, Part: PartMid},
+			str:        "mid-1850s",
+			occurrence: "in the mid-1850s",
+		},
+		{
+			d:          &Decade{Y: 1850, Part: PartSecondHalf},
+			str:        "second half of the 1850s",
+			occurrence: "in the second half of the 1850s",
+		},
+		{
+			d:          &Century{N: 19},
+			str:        "19th century",
+			occurrence: "in the 19th century",
+		},
+		{
+			d:          &Century{N: 21, Part: PartLate},
+			str:        "late 21st century",
+			occurrence: "in the late 21st century",
+		},
+		{
+			d:          &Century{N: 12, Part: PartFirstHalf},
+			str:        "first half of the 12th century",
+			occurrence: "in the first half of the 12th century",
+		},
+		{
+			d:          &YearPart{Y: 1850, Part: PartFirstHalf},
+			str:        "first half of 1850",
+			occurrence: "in the first half of 1850",
+		},
+		{
+			d:          &YearPart{Y: 1850, Part: PartEarly},
+			str:        "early 1850",
+			occurrence: "in early 1850",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.str, func(t *testing.T) {
+			if got := tc.d.String(); got != tc.str {
+				t.Errorf("got String()=%q, want %q", got, tc.str)
+			}
+			if got := tc.d.Occurrence(); got != tc.occurrence {
+				t.Errorf("got Occurrence()=%q, want %q", got, tc.occurrence)
+			}
+		})
+	}
+}
+
+func TestSpanBounds(t *testing.T) {
+	testCases := []struct {
+		d        ComparableDate
+		earliest *Precise
+		latest   *Precise
+	}{
+		{
+			d:        &Decade{Y: 1850},
+			earliest: &Precise{Y: 1850, M: 1, D: 1},
+			latest:   &Precise{Y: 1859, M: 12, D: 31},
+		},
+		{
+			d:        &Decade{Y: 1850, Part: PartEarly},
+			earliest: &Precise{Y: 1850, M: 1, D: 1},
+			latest:   &Precise{Y: 1853, M: 12, D: 31},
+		},
+		{
+			d:        &Decade{Y: 1850, Part: PartMid},
+			earliest: &Precise{Y: 1854, M: 1, D: 1},
+			latest:   &Precise{Y: 1856, M: 12, D: 31},
+		},
+		{
+			d:        &Decade{Y: 1850, Part: PartLate},
+			earliest: &Precise{Y: 1857, M: 1, D: 1},
+			latest:   &Precise{Y: 1859, M: 12, D: 31},
+		},
+		{
+			d:        &Century{N: 19},
+			earliest: &Precise{Y: 1800, M: 1, D: 1},
+			latest:   &Precise{Y: 1899, M: 12, D: 31},
+		},
+		{
+			d:        &Century{N: 18, Part: PartLate},
+			earliest: &Precise{Y: 1767, M: 1, D: 1},
+			latest:   &Precise{Y: 1799, M: 12, D: 31},
+		},
+		{
+			d:        &Century{N: 18, Part: PartSecondHalf},
+			earliest: &Precise{Y: 1750, M: 1, D: 1},
+			latest:   &Precise{Y: 1799, M: 12, D: 31},
+		},
+		{
+			d:        &YearPart{Y: 1850, Part: PartFirstHalf},
+			earliest: &Precise{Y: 1850, M: 1, D: 1},
+			latest:   &Precise{Y: 1850, M: 6, D: 30},
+		},
+		{
+			d:        &YearPart{Y: 1850, Part: PartMid},
+			earliest: &Precise{Y: 1850, M: 5, D: 1},
+			latest:   &Precise{Y: 1850, M: 8, D: 31},
+		},
+		{
+			d:        &YearPart{Y: 1852, Part: PartEarly},
+			earliest: &Precise{Y: 1852, M: 1, D: 1},
+			latest:   &Precise{Y: 1852, M: 4, D: 30},
+		},
+		{
+			// Old Style: Jan to Apr 1720 include days on both sides of 25 Mar, so span the year as written
+			d:        &YearPart{C: Julian25Mar, Y: 1720, Part: PartEarly},
+			earliest: &Precise{C: Julian, Y: 1720, M: 3, D: 25},
+			latest:   &Precise{C: Julian, Y: 1721, M: 3, D: 24},
+		},
+		{
+			d:        &YearPart{C: Julian25Mar, Y: 1720, Part: PartLate},
+			earliest: &Precise{C: Julian, Y: 1720, M: 9, D: 1},
+			latest:   &Precise{C: Julian, Y: 1720, M: 12, D: 31},
+		},
+		{
+			d:        &Decade{C: Julian25Mar, Y: 1720},
+			earliest: &Precise{C: Julian, Y: 1720, M: 3, D: 25},
+			latest:   &Precise{C: Julian, Y: 1730, M: 3, D: 24},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.d.(Date).String(), func(t *testing.T) {
+			if got, want := tc.d.EarliestJulianDay(), tc.earliest.EarliestJulianDay(); got != want {
+				t.Errorf("got earliest %d, want %d (%s)", got, want, tc.earliest)
+			}
+			if got, want := tc.d.LatestJulianDay(), tc.latest.LatestJulianDay(); got != want {
+				t.Errorf("got latest %d, want %d (%s)", got, want, tc.latest)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package gdate
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokNumber tokenKind = 1
+	tokWord   tokenKind = 2
+	tokPunct  tokenKind = 3
+)
+
+// A token is a run of digits, a run of letters or a single punctuation character.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+
+	// num is the value of a number token, or -1 if the number is too long to be useful.
+	num int
+
+	// expanded is true when the token is a two digit year that has been expanded to the four digit year in num.
+	expanded bool
+}
+
+// end returns the byte offset of the end of the token
+func (t token) end() int {
+	return t.pos + len(t.text)
+}
+
+// is reports whether the token is the word or punctuation w, ignoring case
+func (t token) is(w string) bool {
+	return t.kind != tokNumber && equalFoldASCII(t.text, w)
+}
+
+// tokenize splits s into tokens, ignoring whitespace
+func tokenize(s string) []token {
+	return appendTokens(nil, s)
+}
+
+// appendTokens appends the tokens of s to toks, allowing callers to supply a buffer.
+func appendTokens(toks []token, s string) []token {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f':
+			i++
+		case isDigit(rune(c)):
+			j := i + 1
+			for j < len(s) && isDigit(rune(s[j])) {
+				j++
+			}
+			n := -1
+			if j-i <= 9 {
+				n = 0
+				for _, d := range s[i:j] {
+					n = n*10 + int(d-'0')
+				}
+			}
+			toks = append(toks, token{kind: tokNumber, text: s[i:j], pos: i, num: n})
+			i = j
+		case c < utf8.RuneSelf:
+			if isLetterASCII(c) {
+				j := i + 1
+				for j < len(s) && isLetterASCII(s[j]) {
+					j++
+				}
+				if j < len(s) && s[j] >= utf8.RuneSelf {
+					j = scanLetters(s, j)
+				}
+				toks = append(toks, token{kind: tokWord, text: s[i:j], pos: i})
+				i = j
+				break
+			}
+			toks = append(toks, token{kind: tokPunct, text: s[i : i+1], pos: i})
+			i++
+		default:
+			r, size := utf8.DecodeRuneInString(s[i:])
+			switch {
+			case unicode.IsSpace(r):
+				i += size
+			case unicode.IsLetter(r):
+				j := scanLetters(s, i)
+				toks = append(toks, token{kind: tokWord, text: s[i:j], pos: i})
+				i = j
+			default:
+				toks = append(toks, token{kind: tokPunct, text: s[i : i+size], pos: i})
+				i += size
+			}
+		}
+	}
+	return toks
+}
+
+// scanLetters returns the offset of the first rune at or after i in s that is not a letter
+func scanLetters(s string, i int) int {
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isLetterASCII(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func lowerASCII(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// equalFoldASCII reports whether s and t are equal ignoring the case of ASCII letters
+func equalFoldASCII(s, t string) bool {
+	if len(s) != len(t) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if lowerASCII(s[i]) != lowerASCII(t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the entry in m for the word w, ignoring case. Words are lowered into a
+// buffer on the stack so that lookups do not allocate.
+func lookup[V any](m map[string]V, w string) (V, bool) {
+	var buf [16]byte
+	if len(w) > len(buf) {
+		var zero V
+		return zero, false
+	}
+	for i := 0; i < len(w); i++ {
+		buf[i] = lowerASCII(w[i])
+	}
+	v, ok := m[string(buf[:len(w)])]
+	return v, ok
+}